@@ -24,18 +24,15 @@ func NewClient(client *internal.Client, openapiClient *openapi.APIClient) *Clien
 
 // GetAccountAsset gets the account asset information
 func (c *Client) GetAccountAsset(ctx context.Context) (*openapi.ResultGetAccountAsset, error) {
-	resp, _, err := c.openapiClient.Class03AccountPrivateApiAPI.GetAccountAsset(ctx).
+	resp, httpResp, err := c.openapiClient.Class03AccountPrivateApiAPI.GetAccountAsset(ctx).
 		AccountId(fmt.Sprintf("%d", c.GetAccountID())).
 		Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get account asset: %w", err)
+		return nil, fmt.Errorf("failed to get account asset: %w", internal.ParseError(err, httpResp))
 	}
 
-	if resp.GetCode() != "SUCCESS" {
-		if errorParam := resp.GetErrorParam(); errorParam != nil {
-			return nil, fmt.Errorf("request failed with error params: %v", errorParam)
-		}
-		return nil, fmt.Errorf("request failed with code: %s", resp.GetCode())
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
 	}
 
 	return resp, nil
@@ -43,18 +40,15 @@ func (c *Client) GetAccountAsset(ctx context.Context) (*openapi.ResultGetAccount
 
 // GetAccountPositions gets the account positions
 func (c *Client) GetAccountPositions(ctx context.Context) (*openapi.ResultListPosition, error) {
-	resp, _, err := c.openapiClient.Class03AccountPrivateApiAPI.GetAccountAsset(ctx).
+	resp, httpResp, err := c.openapiClient.Class03AccountPrivateApiAPI.GetAccountAsset(ctx).
 		AccountId(fmt.Sprintf("%d", c.GetAccountID())).
 		Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get account positions: %w", err)
+		return nil, fmt.Errorf("failed to get account positions: %w", internal.ParseError(err, httpResp))
 	}
 
-	if resp.GetCode() != "SUCCESS" {
-		if errorParam := resp.GetErrorParam(); errorParam != nil {
-			return nil, fmt.Errorf("request failed with error params: %v", errorParam)
-		}
-		return nil, fmt.Errorf("request failed with code: %s", resp.GetCode())
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
 	}
 
 	data := resp.GetData()
@@ -127,16 +121,13 @@ func (c *Client) GetPositionTransactionPage(ctx context.Context, params GetPosit
 		req = req.FilterOpenOnly(fmt.Sprintf("%v", *params.FilterOpenOnly))
 	}
 
-	resp, _, err := req.Execute()
+	resp, httpResp, err := req.Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get position transaction page: %w", err)
+		return nil, fmt.Errorf("failed to get position transaction page: %w", internal.ParseError(err, httpResp))
 	}
 
-	if resp.GetCode() != "SUCCESS" {
-		if errorParam := resp.GetErrorParam(); errorParam != nil {
-			return nil, fmt.Errorf("request failed with error params: %v", errorParam)
-		}
-		return nil, fmt.Errorf("request failed with code: %s", resp.GetCode())
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
 	}
 
 	return resp, nil
@@ -178,16 +169,13 @@ func (c *Client) GetCollateralTransactionPage(ctx context.Context, params GetCol
 		req = req.FilterEndCreatedTimeExclusive(fmt.Sprintf("%d", params.FilterEndCreatedTime))
 	}
 
-	resp, _, err := req.Execute()
+	resp, httpResp, err := req.Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get collateral transaction page: %w", err)
+		return nil, fmt.Errorf("failed to get collateral transaction page: %w", internal.ParseError(err, httpResp))
 	}
 
-	if resp.GetCode() != "SUCCESS" {
-		if errorParam := resp.GetErrorParam(); errorParam != nil {
-			return nil, fmt.Errorf("request failed with error params: %v", errorParam)
-		}
-		return nil, fmt.Errorf("request failed with code: %s", resp.GetCode())
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
 	}
 
 	return resp, nil
@@ -199,19 +187,16 @@ func (c *Client) GetPositionByContractID(ctx context.Context, contractIDs []stri
 		return nil, fmt.Errorf("at least one contractId is required")
 	}
 
-	resp, _, err := c.openapiClient.Class03AccountPrivateApiAPI.GetCollateralByCoinId(ctx).
+	resp, httpResp, err := c.openapiClient.Class03AccountPrivateApiAPI.GetCollateralByCoinId(ctx).
 		AccountId(fmt.Sprintf("%d", c.GetAccountID())).
 		ContractIdList(internal.JoinStrings(contractIDs)).
 		Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get position by contract ID: %w", err)
+		return nil, fmt.Errorf("failed to get position by contract ID: %w", internal.ParseError(err, httpResp))
 	}
 
-	if resp.GetCode() != "SUCCESS" {
-		if errorParam := resp.GetErrorParam(); errorParam != nil {
-			return nil, fmt.Errorf("request failed with error params: %v", errorParam)
-		}
-		return nil, fmt.Errorf("request failed with code: %s", resp.GetCode())
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
 	}
 
 	return resp, nil
@@ -258,16 +243,13 @@ func (c *Client) GetPositionTermPage(ctx context.Context, params GetPositionTerm
 		req = req.FilterEndCreatedTimeExclusive(fmt.Sprintf("%d", params.FilterEndCreatedTime))
 	}
 
-	resp, _, err := req.Execute()
+	resp, httpResp, err := req.Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get position term page: %w", err)
+		return nil, fmt.Errorf("failed to get position term page: %w", internal.ParseError(err, httpResp))
 	}
 
-	if resp.GetCode() != "SUCCESS" {
-		if errorParam := resp.GetErrorParam(); errorParam != nil {
-			return nil, fmt.Errorf("request failed with error params: %v", errorParam)
-		}
-		return nil, fmt.Errorf("request failed with code: %s", resp.GetCode())
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
 	}
 
 	return resp, nil
@@ -282,16 +264,13 @@ func (c *Client) GetCollateralByCoinID(ctx context.Context, coinIDs []string) (*
 		req = req.CoinIdList(internal.JoinStrings(coinIDs))
 	}
 
-	resp, _, err := req.Execute()
+	resp, httpResp, err := req.Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get collateral by coin ID: %w", err)
+		return nil, fmt.Errorf("failed to get collateral by coin ID: %w", internal.ParseError(err, httpResp))
 	}
 
-	if resp.GetCode() != "SUCCESS" {
-		if errorParam := resp.GetErrorParam(); errorParam != nil {
-			return nil, fmt.Errorf("request failed with error params: %v", errorParam)
-		}
-		return nil, fmt.Errorf("request failed with code: %s", resp.GetCode())
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
 	}
 
 	return resp, nil
@@ -299,18 +278,21 @@ func (c *Client) GetCollateralByCoinID(ctx context.Context, coinIDs []string) (*
 
 // GetAccountByID gets account information by ID
 func (c *Client) GetAccountByID(ctx context.Context) (*openapi.ResultAccount, error) {
-	resp, _, err := c.openapiClient.Class03AccountPrivateApiAPI.GetAccountById(ctx).
-		AccountId(fmt.Sprintf("%d", c.GetAccountID())).
+	return c.GetAccountByAccountID(ctx, c.GetAccountID())
+}
+
+// GetAccountByAccountID gets account information of another account of the user,
+// such as a sub-account
+func (c *Client) GetAccountByAccountID(ctx context.Context, accountID int64) (*openapi.ResultAccount, error) {
+	resp, httpResp, err := c.openapiClient.Class03AccountPrivateApiAPI.GetAccountById(ctx).
+		AccountId(fmt.Sprintf("%d", accountID)).
 		Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get account by ID: %w", err)
+		return nil, fmt.Errorf("failed to get account by ID: %w", internal.ParseError(err, httpResp))
 	}
 
-	if resp.GetCode() != "SUCCESS" {
-		if errorParam := resp.GetErrorParam(); errorParam != nil {
-			return nil, fmt.Errorf("request failed with error params: %v", errorParam)
-		}
-		return nil, fmt.Errorf("request failed with code: %s", resp.GetCode())
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
 	}
 
 	return resp, nil
@@ -353,16 +335,13 @@ func (c *Client) GetAccountAssetSnapshotPage(ctx context.Context, params GetAcco
 		req = req.FilterEndTimeExclusive(fmt.Sprintf("%d", params.FilterEndTime))
 	}
 
-	resp, _, err := req.Execute()
+	resp, httpResp, err := req.Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get account asset snapshot page: %w", err)
+		return nil, fmt.Errorf("failed to get account asset snapshot page: %w", internal.ParseError(err, httpResp))
 	}
 
-	if resp.GetCode() != "SUCCESS" {
-		if errorParam := resp.GetErrorParam(); errorParam != nil {
-			return nil, fmt.Errorf("request failed with error params: %v", errorParam)
-		}
-		return nil, fmt.Errorf("request failed with code: %s", resp.GetCode())
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
 	}
 
 	return resp, nil
@@ -374,19 +353,16 @@ func (c *Client) GetPositionTransactionByID(ctx context.Context, transactionIDs
 		return nil, fmt.Errorf("at least one transactionId is required")
 	}
 
-	resp, _, err := c.openapiClient.Class03AccountPrivateApiAPI.GetPositionTransactionById(ctx).
+	resp, httpResp, err := c.openapiClient.Class03AccountPrivateApiAPI.GetPositionTransactionById(ctx).
 		AccountId(fmt.Sprintf("%d", c.GetAccountID())).
 		PositionTransactionIdList(internal.JoinStrings(transactionIDs)).
 		Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get position transaction by ID: %w", err)
+		return nil, fmt.Errorf("failed to get position transaction by ID: %w", internal.ParseError(err, httpResp))
 	}
 
-	if resp.GetCode() != "SUCCESS" {
-		if errorParam := resp.GetErrorParam(); errorParam != nil {
-			return nil, fmt.Errorf("request failed with error params: %v", errorParam)
-		}
-		return nil, fmt.Errorf("request failed with code: %s", resp.GetCode())
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
 	}
 
 	return resp, nil
@@ -398,19 +374,16 @@ func (c *Client) GetCollateralTransactionByID(ctx context.Context, transactionID
 		return nil, fmt.Errorf("at least one transactionId is required")
 	}
 
-	resp, _, err := c.openapiClient.Class03AccountPrivateApiAPI.GetCollateralTransactionById(ctx).
+	resp, httpResp, err := c.openapiClient.Class03AccountPrivateApiAPI.GetCollateralTransactionById(ctx).
 		AccountId(fmt.Sprintf("%d", c.GetAccountID())).
 		CollateralTransactionIdList(internal.JoinStrings(transactionIDs)).
 		Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get collateral transaction by ID: %w", err)
+		return nil, fmt.Errorf("failed to get collateral transaction by ID: %w", internal.ParseError(err, httpResp))
 	}
 
-	if resp.GetCode() != "SUCCESS" {
-		if errorParam := resp.GetErrorParam(); errorParam != nil {
-			return nil, fmt.Errorf("request failed with error params: %v", errorParam)
-		}
-		return nil, fmt.Errorf("request failed with code: %s", resp.GetCode())
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
 	}
 
 	return resp, nil
@@ -418,18 +391,15 @@ func (c *Client) GetCollateralTransactionByID(ctx context.Context, transactionID
 
 // GetAccountDeleverageLight gets account deleverage light information
 func (c *Client) GetAccountDeleverageLight(ctx context.Context) (*openapi.ResultGetAccountDeleverageLight, error) {
-	resp, _, err := c.openapiClient.Class03AccountPrivateApiAPI.GetAccountDeleverageLight(ctx).
+	resp, httpResp, err := c.openapiClient.Class03AccountPrivateApiAPI.GetAccountDeleverageLight(ctx).
 		AccountId(fmt.Sprintf("%d", c.GetAccountID())).
 		Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get account deleverage light: %w", err)
+		return nil, fmt.Errorf("failed to get account deleverage light: %w", internal.ParseError(err, httpResp))
 	}
 
-	if resp.GetCode() != "SUCCESS" {
-		if errorParam := resp.GetErrorParam(); errorParam != nil {
-			return nil, fmt.Errorf("request failed with error params: %v", errorParam)
-		}
-		return nil, fmt.Errorf("request failed with code: %s", resp.GetCode())
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
 	}
 
 	return resp, nil
@@ -442,18 +412,15 @@ func (c *Client) UpdateLeverageSetting(ctx context.Context, contractID string, l
 	param.SetContractId(contractID)
 	param.SetLeverage(leverage)
 
-	resp, _, err := c.openapiClient.Class03AccountPrivateApiAPI.UpdateLeverageSetting(ctx).
+	resp, httpResp, err := c.openapiClient.Class03AccountPrivateApiAPI.UpdateLeverageSetting(ctx).
 		UpdateLeverageSettingParam(*param).
 		Execute()
 	if err != nil {
-		return fmt.Errorf("failed to update leverage setting: %w", err)
+		return fmt.Errorf("failed to update leverage setting: %w", internal.ParseError(err, httpResp))
 	}
 
-	if resp.GetCode() != "SUCCESS" {
-		if errorParam := resp.GetErrorParam(); errorParam != nil {
-			return fmt.Errorf("request failed with error params: %v", errorParam)
-		}
-		return fmt.Errorf("request failed with code: %s", resp.GetCode())
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return err
 	}
 
 	return nil
@@ -0,0 +1,101 @@
+package account
+
+import (
+	"context"
+	"time"
+
+	openapi "github.com/edgex-Tech/edgex-golang-sdk/openapi"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/pager"
+)
+
+// PositionTransactionsPager returns a pager over all position transactions matching
+// the filters. The Size and OffsetData of params are managed by the pager.
+func (c *Client) PositionTransactionsPager(params GetPositionTransactionPageParams, opts *pager.Options) *pager.Pager[openapi.PositionTransaction] {
+	return pager.New(func(ctx context.Context, offset string, size int) ([]openapi.PositionTransaction, string, error) {
+		params.Size, params.OffsetData = int32(size), offset
+		resp, err := c.GetPositionTransactionPage(ctx, params)
+		if err != nil {
+			return nil, "", err
+		}
+		data := resp.GetData()
+		return data.GetDataList(), data.GetNextPageOffsetData(), nil
+	}, opts)
+}
+
+// CollateralTransactionsPager returns a pager over all collateral transactions matching
+// the filters. The Size and OffsetData of params are managed by the pager.
+func (c *Client) CollateralTransactionsPager(params GetCollateralTransactionPageParams, opts *pager.Options) *pager.Pager[openapi.CollateralTransaction] {
+	return pager.New(func(ctx context.Context, offset string, size int) ([]openapi.CollateralTransaction, string, error) {
+		params.Size, params.OffsetData = int32(size), offset
+		resp, err := c.GetCollateralTransactionPage(ctx, params)
+		if err != nil {
+			return nil, "", err
+		}
+		data := resp.GetData()
+		return data.GetDataList(), data.GetNextPageOffsetData(), nil
+	}, opts)
+}
+
+// PositionTermsPager returns a pager over all position terms matching the filters.
+// The Size and OffsetData of params are managed by the pager.
+func (c *Client) PositionTermsPager(params GetPositionTermPageParams, opts *pager.Options) *pager.Pager[openapi.PositionTerm] {
+	return pager.New(func(ctx context.Context, offset string, size int) ([]openapi.PositionTerm, string, error) {
+		params.Size, params.OffsetData = int32(size), offset
+		resp, err := c.GetPositionTermPage(ctx, params)
+		if err != nil {
+			return nil, "", err
+		}
+		data := resp.GetData()
+		return data.GetDataList(), data.GetNextPageOffsetData(), nil
+	}, opts)
+}
+
+// AccountAssetSnapshotsPager returns a pager over all account asset snapshots matching
+// the filters. The Size and OffsetData of params are managed by the pager.
+func (c *Client) AccountAssetSnapshotsPager(params GetAccountAssetSnapshotPageParams, opts *pager.Options) *pager.Pager[openapi.AccountAssetSnapshot] {
+	return pager.New(func(ctx context.Context, offset string, size int) ([]openapi.AccountAssetSnapshot, string, error) {
+		params.Size, params.OffsetData = int32(size), offset
+		resp, err := c.GetAccountAssetSnapshotPage(ctx, params)
+		if err != nil {
+			return nil, "", err
+		}
+		data := resp.GetData()
+		return data.GetDataList(), data.GetNextPageOffsetData(), nil
+	}, opts)
+}
+
+// CollectPositionTransactions collects all position transactions created in the window,
+// fetching it in spans of w.Span. The time filters of params are replaced by each span.
+func (c *Client) CollectPositionTransactions(ctx context.Context, params GetPositionTransactionPageParams, w pager.Window, opts *pager.Options) ([]openapi.PositionTransaction, error) {
+	return pager.Collect(ctx, w, opts, func(start, end time.Time, opts *pager.Options) *pager.Pager[openapi.PositionTransaction] {
+		params.FilterStartCreatedTime, params.FilterEndCreatedTime = start.UnixMilli(), end.UnixMilli()
+		return c.PositionTransactionsPager(params, opts)
+	})
+}
+
+// CollectCollateralTransactions collects all collateral transactions created in the
+// window, fetching it in spans of w.Span. The time filters of params are replaced by each span.
+func (c *Client) CollectCollateralTransactions(ctx context.Context, params GetCollateralTransactionPageParams, w pager.Window, opts *pager.Options) ([]openapi.CollateralTransaction, error) {
+	return pager.Collect(ctx, w, opts, func(start, end time.Time, opts *pager.Options) *pager.Pager[openapi.CollateralTransaction] {
+		params.FilterStartCreatedTime, params.FilterEndCreatedTime = start.UnixMilli(), end.UnixMilli()
+		return c.CollateralTransactionsPager(params, opts)
+	})
+}
+
+// CollectPositionTerms collects all position terms created in the window, fetching it
+// in spans of w.Span. The time filters of params are replaced by each span.
+func (c *Client) CollectPositionTerms(ctx context.Context, params GetPositionTermPageParams, w pager.Window, opts *pager.Options) ([]openapi.PositionTerm, error) {
+	return pager.Collect(ctx, w, opts, func(start, end time.Time, opts *pager.Options) *pager.Pager[openapi.PositionTerm] {
+		params.FilterStartCreatedTime, params.FilterEndCreatedTime = start.UnixMilli(), end.UnixMilli()
+		return c.PositionTermsPager(params, opts)
+	})
+}
+
+// CollectAccountAssetSnapshots collects all account asset snapshots in the window,
+// fetching it in spans of w.Span. The time filters of params are replaced by each span.
+func (c *Client) CollectAccountAssetSnapshots(ctx context.Context, params GetAccountAssetSnapshotPageParams, w pager.Window, opts *pager.Options) ([]openapi.AccountAssetSnapshot, error) {
+	return pager.Collect(ctx, w, opts, func(start, end time.Time, opts *pager.Options) *pager.Pager[openapi.AccountAssetSnapshot] {
+		params.FilterStartTime, params.FilterEndTime = start.UnixMilli(), end.UnixMilli()
+		return c.AccountAssetSnapshotsPager(params, opts)
+	})
+}
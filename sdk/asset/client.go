@@ -3,10 +3,14 @@ package asset
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"strconv"
+	"strings"
+	"time"
 
 	openapi "github.com/edgex-Tech/edgex-golang-sdk/openapi"
 	"github.com/edgex-Tech/edgex-golang-sdk/sdk/internal"
+	"github.com/shopspring/decimal"
 )
 
 // Client represents the asset client
@@ -60,16 +64,13 @@ func (c *Client) GetAllOrdersPage(ctx context.Context, params GetAllOrdersPagePa
 		req = req.OffsetData(params.OffsetData)
 	}
 
-	resp, _, err := req.Execute()
+	resp, httpResp, err := req.Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get asset orders: %w", err)
+		return nil, fmt.Errorf("failed to get asset orders: %w", internal.ParseError(err, httpResp))
 	}
 
-	if resp.GetCode() != "SUCCESS" {
-		if errorParam := resp.GetErrorParam(); errorParam != nil {
-			return nil, fmt.Errorf("request failed with error params: %v", errorParam)
-		}
-		return nil, fmt.Errorf("request failed with code: %s", resp.GetCode())
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
 	}
 
 	return resp, nil
@@ -92,16 +93,13 @@ func (c *Client) GetCoinRate(ctx context.Context, params GetCoinRateParams) (*op
 		req = req.Coin(params.Coin)
 	}
 
-	resp, _, err := req.Execute()
+	resp, httpResp, err := req.Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get coin rate: %w", err)
+		return nil, fmt.Errorf("failed to get coin rate: %w", internal.ParseError(err, httpResp))
 	}
 
-	if resp.GetCode() != "SUCCESS" {
-		if errorParam := resp.GetErrorParam(); errorParam != nil {
-			return nil, fmt.Errorf("request failed with error params: %v", errorParam)
-		}
-		return nil, fmt.Errorf("request failed with code: %s", resp.GetCode())
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
 	}
 
 	return resp, nil
@@ -123,16 +121,13 @@ func (c *Client) GetCrossWithdrawById(ctx context.Context, params GetCrossWithdr
 		req = req.CrossWithdrawIdList(params.CrossWithdrawIdList)
 	}
 
-	resp, _, err := req.Execute()
+	resp, httpResp, err := req.Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get cross withdraw by id: %w", err)
+		return nil, fmt.Errorf("failed to get cross withdraw by id: %w", internal.ParseError(err, httpResp))
 	}
 
-	if resp.GetCode() != "SUCCESS" {
-		if errorParam := resp.GetErrorParam(); errorParam != nil {
-			return nil, fmt.Errorf("request failed with error params: %v", errorParam)
-		}
-		return nil, fmt.Errorf("request failed with code: %s", resp.GetCode())
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
 	}
 
 	return resp, nil
@@ -155,16 +150,13 @@ func (c *Client) GetCrossWithdrawSignInfo(ctx context.Context, params GetCrossWi
 		req = req.Amount(params.Amount)
 	}
 
-	resp, _, err := req.Execute()
+	resp, httpResp, err := req.Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get cross withdraw sign info: %w", err)
+		return nil, fmt.Errorf("failed to get cross withdraw sign info: %w", internal.ParseError(err, httpResp))
 	}
 
-	if resp.GetCode() != "SUCCESS" {
-		if errorParam := resp.GetErrorParam(); errorParam != nil {
-			return nil, fmt.Errorf("request failed with error params: %v", errorParam)
-		}
-		return nil, fmt.Errorf("request failed with code: %s", resp.GetCode())
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
 	}
 
 	return resp, nil
@@ -186,16 +178,13 @@ func (c *Client) GetFastWithdrawById(ctx context.Context, params GetFastWithdraw
 		req = req.FastWithdrawIdList(params.FastWithdrawIdList)
 	}
 
-	resp, _, err := req.Execute()
+	resp, httpResp, err := req.Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get fast withdraw by id: %w", err)
+		return nil, fmt.Errorf("failed to get fast withdraw by id: %w", internal.ParseError(err, httpResp))
 	}
 
-	if resp.GetCode() != "SUCCESS" {
-		if errorParam := resp.GetErrorParam(); errorParam != nil {
-			return nil, fmt.Errorf("request failed with error params: %v", errorParam)
-		}
-		return nil, fmt.Errorf("request failed with code: %s", resp.GetCode())
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
 	}
 
 	return resp, nil
@@ -218,16 +207,13 @@ func (c *Client) GetFastWithdrawSignInfo(ctx context.Context, params GetFastWith
 		req = req.Amount(params.Amount)
 	}
 
-	resp, _, err := req.Execute()
+	resp, httpResp, err := req.Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get fast withdraw sign info: %w", err)
+		return nil, fmt.Errorf("failed to get fast withdraw sign info: %w", internal.ParseError(err, httpResp))
 	}
 
-	if resp.GetCode() != "SUCCESS" {
-		if errorParam := resp.GetErrorParam(); errorParam != nil {
-			return nil, fmt.Errorf("request failed with error params: %v", errorParam)
-		}
-		return nil, fmt.Errorf("request failed with code: %s", resp.GetCode())
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
 	}
 
 	return resp, nil
@@ -249,16 +235,13 @@ func (c *Client) GetNormalWithdrawById(ctx context.Context, params GetNormalWith
 		req = req.NormalWithdrawIdList(params.NormalWithdrawIdList)
 	}
 
-	resp, _, err := req.Execute()
+	resp, httpResp, err := req.Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get normal withdraw by id: %w", err)
+		return nil, fmt.Errorf("failed to get normal withdraw by id: %w", internal.ParseError(err, httpResp))
 	}
 
-	if resp.GetCode() != "SUCCESS" {
-		if errorParam := resp.GetErrorParam(); errorParam != nil {
-			return nil, fmt.Errorf("request failed with error params: %v", errorParam)
-		}
-		return nil, fmt.Errorf("request failed with code: %s", resp.GetCode())
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
 	}
 
 	return resp, nil
@@ -277,16 +260,13 @@ func (c *Client) GetNormalWithdrawableAmount(ctx context.Context, params GetNorm
 		req = req.Address(params.Address)
 	}
 
-	resp, _, err := req.Execute()
+	resp, httpResp, err := req.Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get normal withdrawable amount: %w", err)
+		return nil, fmt.Errorf("failed to get normal withdrawable amount: %w", internal.ParseError(err, httpResp))
 	}
 
-	if resp.GetCode() != "SUCCESS" {
-		if errorParam := resp.GetErrorParam(); errorParam != nil {
-			return nil, fmt.Errorf("request failed with error params: %v", errorParam)
-		}
-		return nil, fmt.Errorf("request failed with code: %s", resp.GetCode())
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
 	}
 
 	return resp, nil
@@ -296,40 +276,79 @@ func (c *Client) GetNormalWithdrawableAmount(ctx context.Context, params GetNorm
 type CreateNormalWithdrawParams struct {
 	CoinId           string
 	Amount           string
-	EthAddress       string
-	ClientWithdrawId string
-	ExpireTime       string
-	L2Signature      string
+	EthAddress       string // Destination L1 address
+	ClientWithdrawId string // Client defined ID, generated if empty
+
+	// Withdrawal expiry, either absolute or relative to now. When neither is
+	// set the L2 signature expires after 14 days.
+	ExpireTime  *time.Time
+	ExpireAfter time.Duration
 }
 
-// CreateNormalWithdraw creates a normal withdrawal order
-func (c *Client) CreateNormalWithdraw(ctx context.Context, params CreateNormalWithdrawParams) (*openapi.ResultCreateNormalWithdraw, error) {
-	req := c.openapiClient.Class09AssetsPrivateApiAPI.CreateNormalWithdraw(ctx)
+// CreateNormalWithdraw creates a normal withdrawal order to an L1 address, signed
+// with the StarkEx withdrawal to address hash
+func (c *Client) CreateNormalWithdraw(ctx context.Context, params CreateNormalWithdrawParams, metadata openapi.MetaData) (*openapi.ResultCreateNormalWithdraw, error) {
+	// Generate client withdraw ID if not provided. A caller supplied ID is
+	// deduplicated by the server, so the request can be retried
+	if params.ClientWithdrawId == "" {
+		params.ClientWithdrawId = internal.GenerateUUID()
+	} else {
+		ctx = internal.WithIdempotent(ctx)
+	}
 
-	// Convert account ID to string
-	accountId := strconv.FormatInt(c.GetAccountID(), 10)
+	// Withdrawals only carry the L2 expire time
+	expiry, err := internal.ResolveL2Expiry(time.Now(), params.ExpireTime, params.ExpireAfter)
+	if err != nil {
+		return nil, err
+	}
 
-	// Create request body
-	body := openapi.CreateNormalWithdrawParam{
-		AccountId:        &accountId,
-		CoinId:           &params.CoinId,
-		Amount:           &params.Amount,
-		EthAddress:       &params.EthAddress,
-		ClientWithdrawId: &params.ClientWithdrawId,
-		ExpireTime:       &params.ExpireTime,
-		L2Signature:      &params.L2Signature,
+	amountDm, err := decimal.NewFromString(params.Amount)
+	if err != nil || !amountDm.IsPositive() {
+		return nil, fmt.Errorf("invalid withdraw amount: %s", params.Amount)
 	}
+	amount := amountDm.Shift(6).IntPart()
+	nonce := internal.CalcNonce(params.ClientWithdrawId)
 
-	resp, _, err := req.CreateNormalWithdrawParam(body).Execute()
+	assetID, err := internal.CoinAssetID(metadata, params.CoinId)
+	if err != nil {
+		return nil, err
+	}
+	ethAddress, ok := new(big.Int).SetString(strings.TrimPrefix(params.EthAddress, "0x"), 16)
+	if !ok {
+		return nil, fmt.Errorf("invalid eth address: %s", params.EthAddress)
+	}
+
+	// Calculate withdrawal hash and sign it, the position ID is the account ID
+	msgHash := internal.CalcWithdrawalToAddressHash(
+		assetID,
+		ethAddress,
+		c.GetAccountID(),
+		nonce,
+		amount,
+		expiry.L2ExpireHour(),
+	)
+	signature, err := c.Sign(msgHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign withdrawal hash: %w", err)
+	}
+
+	body := openapi.CreateNormalWithdrawParam{}
+	body.SetAccountId(strconv.FormatInt(c.GetAccountID(), 10))
+	body.SetCoinId(params.CoinId)
+	body.SetAmount(amountDm.String())
+	body.SetEthAddress(params.EthAddress)
+	body.SetClientWithdrawId(params.ClientWithdrawId)
+	body.SetExpireTime(strconv.FormatInt(expiry.L2ExpireTime, 10))
+	body.SetL2Signature(fmt.Sprintf("%s%s%s", signature.R, signature.S, signature.V))
+
+	req := c.openapiClient.Class09AssetsPrivateApiAPI.CreateNormalWithdraw(ctx)
+	resp, httpResp, err := req.CreateNormalWithdrawParam(body).Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to create normal withdraw: %w", err)
+		return nil, fmt.Errorf("failed to create normal withdraw: %w", internal.ParseError(err, httpResp))
 	}
 
-	if resp.GetCode() != "SUCCESS" {
-		if errorParam := resp.GetErrorParam(); errorParam != nil {
-			return nil, fmt.Errorf("request failed with error params: %v", errorParam)
-		}
-		return nil, fmt.Errorf("request failed with code: %s", resp.GetCode())
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
 	}
 
 	return resp, nil
@@ -377,16 +396,13 @@ func (c *Client) CreateCrossWithdraw(ctx context.Context, params CreateCrossWith
 		MpcSignTime:           &params.MpcSignTime,
 	}
 
-	resp, _, err := req.CreateCrossWithdrawParam(body).Execute()
+	resp, httpResp, err := req.CreateCrossWithdrawParam(body).Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to create cross withdraw: %w", err)
+		return nil, fmt.Errorf("failed to create cross withdraw: %w", internal.ParseError(err, httpResp))
 	}
 
-	if resp.GetCode() != "SUCCESS" {
-		if errorParam := resp.GetErrorParam(); errorParam != nil {
-			return nil, fmt.Errorf("request failed with error params: %v", errorParam)
-		}
-		return nil, fmt.Errorf("request failed with code: %s", resp.GetCode())
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
 	}
 
 	return resp, nil
@@ -0,0 +1,34 @@
+package asset
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	openapi "github.com/edgex-Tech/edgex-golang-sdk/openapi"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/pager"
+)
+
+// AllOrdersPager returns a pager over all asset orders matching the filters.
+// The Size and OffsetData of params are managed by the pager.
+func (c *Client) AllOrdersPager(params GetAllOrdersPageParams, opts *pager.Options) *pager.Pager[openapi.AssetOrder] {
+	return pager.New(func(ctx context.Context, offset string, size int) ([]openapi.AssetOrder, string, error) {
+		params.Size, params.OffsetData = strconv.Itoa(size), offset
+		resp, err := c.GetAllOrdersPage(ctx, params)
+		if err != nil {
+			return nil, "", err
+		}
+		data := resp.GetData()
+		return data.GetDataList(), data.GetNextPageOffsetData(), nil
+	}, opts)
+}
+
+// CollectAllOrders collects all asset orders in the window, fetching it in spans
+// of w.Span. The time filters of params are replaced by each span.
+func (c *Client) CollectAllOrders(ctx context.Context, params GetAllOrdersPageParams, w pager.Window, opts *pager.Options) ([]openapi.AssetOrder, error) {
+	return pager.Collect(ctx, w, opts, func(start, end time.Time, opts *pager.Options) *pager.Pager[openapi.AssetOrder] {
+		params.StartTime = strconv.FormatInt(start.UnixMilli(), 10)
+		params.EndTime = strconv.FormatInt(end.UnixMilli(), 10)
+		return c.AllOrdersPager(params, opts)
+	})
+}
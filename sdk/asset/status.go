@@ -0,0 +1,102 @@
+package asset
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	openapi "github.com/edgex-Tech/edgex-golang-sdk/openapi"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/internal"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/withdraw"
+)
+
+// DefaultPollInterval is the default interval between withdrawal status checks
+const DefaultPollInterval = 5 * time.Second
+
+// ErrWithdrawFailed is returned by the withdrawal trackers when the withdrawal was rejected
+var ErrWithdrawFailed = errors.New("withdraw failed")
+
+// WaitForWithdrawParams represents the parameters of the withdrawal trackers
+type WaitForWithdrawParams struct {
+	// PollInterval is the interval between status checks, 0 uses DefaultPollInterval
+	PollInterval time.Duration
+	// OnStatus is called whenever the status of the withdrawal changes
+	OnStatus func(status string)
+}
+
+// WaitForFastWithdraw polls a fast withdrawal until it succeeds or fails. It returns the
+// last state of the withdrawal, with an error wrapping ErrWithdrawFailed if it failed.
+// Bound the wait with the deadline of ctx.
+func (c *Client) WaitForFastWithdraw(ctx context.Context, fastWithdrawId string, params *WaitForWithdrawParams) (*openapi.FastWithdraw, error) {
+	return pollWithdraw(ctx, params, func(ctx context.Context) (*openapi.FastWithdraw, string, string, error) {
+		resp, err := c.GetFastWithdrawById(ctx, GetFastWithdrawByIdParams{FastWithdrawIdList: fastWithdrawId})
+		if err != nil {
+			return nil, "", "", err
+		}
+		withdraws := resp.GetData()
+		if len(withdraws) == 0 {
+			return nil, "", "", fmt.Errorf("fast withdraw %s not found", fastWithdrawId)
+		}
+		w := &withdraws[0]
+		return w, w.GetStatus(), internal.FirstNonEmpty(w.GetCensorFailReason(), w.GetL2RejectReason(), w.GetL1RejectedReasonMsg()), nil
+	})
+}
+
+// WaitForCrossWithdraw polls a cross-chain withdrawal until it succeeds or fails. It returns
+// the last state of the withdrawal, with an error wrapping ErrWithdrawFailed if it failed.
+// Bound the wait with the deadline of ctx.
+func (c *Client) WaitForCrossWithdraw(ctx context.Context, crossWithdrawId string, params *WaitForWithdrawParams) (*openapi.CrossWithdraw, error) {
+	return pollWithdraw(ctx, params, func(ctx context.Context) (*openapi.CrossWithdraw, string, string, error) {
+		resp, err := c.GetCrossWithdrawById(ctx, GetCrossWithdrawByIdParams{CrossWithdrawIdList: crossWithdrawId})
+		if err != nil {
+			return nil, "", "", err
+		}
+		withdraws := resp.GetData()
+		if len(withdraws) == 0 {
+			return nil, "", "", fmt.Errorf("cross withdraw %s not found", crossWithdrawId)
+		}
+		w := &withdraws[0]
+		return w, w.GetStatus(), internal.FirstNonEmpty(w.GetCensorFailReason(), w.GetL2RejectReason(), w.GetL1RejectedReasonMsg()), nil
+	})
+}
+
+// pollWithdraw polls a withdrawal with get until its status is final
+func pollWithdraw[T any](ctx context.Context, params *WaitForWithdrawParams, get func(ctx context.Context) (*T, string, string, error)) (*T, error) {
+	if params == nil {
+		params = &WaitForWithdrawParams{}
+	}
+	interval := params.PollInterval
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var lastStatus string
+	for {
+		w, status, reason, err := get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if status != lastStatus {
+			lastStatus = status
+			if params.OnStatus != nil {
+				params.OnStatus(status)
+			}
+		}
+
+		switch withdraw.StateOf(status) {
+		case withdraw.StateFailed:
+			return w, fmt.Errorf("%w: %s %s", ErrWithdrawFailed, status, reason)
+		case withdraw.StateSucceeded:
+			return w, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return w, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,411 @@
+package asset
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	openapi "github.com/edgex-Tech/edgex-golang-sdk/openapi"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/internal"
+	"github.com/shopspring/decimal"
+	"golang.org/x/crypto/sha3"
+)
+
+// ErrWithdrawAmountExceeded is returned when a withdrawal exceeds the maximum amount
+// the liquidity provider accepts
+var ErrWithdrawAmountExceeded = errors.New("withdraw amount exceeds maximum")
+
+// CreateFastWithdrawParams represents the parameters for CreateFastWithdraw
+type CreateFastWithdrawParams struct {
+	CoinId               string
+	Amount               string
+	EthAddress           string
+	Erc20Address         string
+	LpAccountId          string
+	ClientFastWithdrawId string
+	ExpireTime           string
+	L2Signature          string
+	Fee                  string
+	FactRegistryAddress  string
+	Fact                 string
+	ChainId              string
+}
+
+// CreateFastWithdraw creates a fast withdrawal order from a precomputed fact and signature.
+// Use FastWithdraw to have the SDK build and sign them.
+func (c *Client) CreateFastWithdraw(ctx context.Context, params CreateFastWithdrawParams) (*openapi.ResultCreateFastWithdraw, error) {
+	req := c.openapiClient.Class09AssetsPrivateApiAPI.CreateFastWithdraw(ctx)
+
+	// Convert account ID to string
+	accountId := strconv.FormatInt(c.GetAccountID(), 10)
+
+	// Create request body
+	body := openapi.CreateFastWithdrawRequest{
+		AccountId:            &accountId,
+		CoinId:               &params.CoinId,
+		Amount:               &params.Amount,
+		EthAddress:           &params.EthAddress,
+		Erc20Address:         &params.Erc20Address,
+		LpAccountId:          &params.LpAccountId,
+		ClientFastWithdrawId: &params.ClientFastWithdrawId,
+		ExpireTime:           &params.ExpireTime,
+		L2Signature:          &params.L2Signature,
+		Fee:                  &params.Fee,
+		FactRegistryAddress:  &params.FactRegistryAddress,
+		Fact:                 &params.Fact,
+		ChainId:              &params.ChainId,
+	}
+
+	resp, httpResp, err := req.CreateFastWithdrawRequest(body).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create fast withdraw: %w", internal.ParseError(err, httpResp))
+	}
+
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// WithdrawParams represents the parameters of a fast or cross-chain withdrawal
+type WithdrawParams struct {
+	CoinId     string
+	Amount     string // Amount received on L1, the fee is paid on top
+	EthAddress string // Destination L1 address
+	ChainId    string // Destination chain
+	// Erc20Address is the token received on L1, empty uses the first
+	// withdrawable token of the chain
+	Erc20Address     string
+	ClientWithdrawId string // Client defined ID, generated if empty
+
+	// Withdrawal expiry, either absolute or relative to now. When neither is
+	// set the L2 signature expires after 14 days.
+	ExpireTime  *time.Time
+	ExpireAfter time.Duration
+}
+
+// CrossWithdrawParams represents the parameters for CrossWithdraw
+type CrossWithdrawParams struct {
+	WithdrawParams
+
+	// MPC approval of the withdrawal, if required by the chain
+	MpcAddress   string
+	MpcSignature string
+	MpcSignTime  string
+}
+
+// WithdrawResult holds the outcome of a fast or cross-chain withdrawal
+type WithdrawResult struct {
+	WithdrawId          string // Fast or cross withdraw ID
+	ClientWithdrawId    string
+	LpAccountId         string // Liquidity provider account paying out on L1
+	Amount              string
+	Fee                 string
+	Erc20Address        string
+	L2Nonce             int64
+	L2ExpireTime        int64  // L2 signature expire time in unix milliseconds
+	Fact                string // L1 fact of a fast withdrawal, empty for cross withdrawals
+	FactRegistryAddress string
+}
+
+// FastWithdraw withdraws through the fast withdraw liquidity provider. It fetches the
+// sign info, checks the amount against the maximum, builds the L1 transfer fact and
+// signs a conditional transfer of amount plus fee to the provider that settles once
+// the fact is registered on L1.
+func (c *Client) FastWithdraw(ctx context.Context, params WithdrawParams, metadata openapi.MetaData) (*WithdrawResult, error) {
+	// A caller supplied ID is deduplicated by the server, so the request can be retried
+	if params.ClientWithdrawId != "" {
+		ctx = internal.WithIdempotent(ctx)
+	}
+
+	signInfoResp, err := c.GetFastWithdrawSignInfo(ctx, GetFastWithdrawSignInfoParams{ChainId: params.ChainId, Amount: params.Amount})
+	if err != nil {
+		return nil, err
+	}
+	signInfo := signInfoResp.GetData()
+	global := metadata.GetGlobal()
+
+	lp := lpTransfer{
+		accountId: internal.FirstNonEmpty(signInfo.GetLpAccountId(), global.GetFastWithdrawAccountId()),
+		l2Key:     internal.FirstNonEmpty(signInfo.GetFastWithdrawL2Key(), global.GetFastWithdrawAccountL2Key()),
+		maxAmount: internal.FirstNonEmpty(signInfo.GetFastWithdrawMaxAmount(), global.GetFastWithdrawMaxAmount()),
+		fee:       signInfo.GetFee(),
+	}
+	factRegistry := internal.FirstNonEmpty(signInfo.GetFastWithdrawFactRegisterAddress(), global.GetFastWithdrawRegistryAddress())
+	if factRegistry == "" {
+		return nil, fmt.Errorf("fast withdraw fact registry address not available")
+	}
+
+	result, err := c.prepareLpTransfer(&params, metadata, &lp)
+	if err != nil {
+		return nil, err
+	}
+
+	// The provider pays out on L1 and registers the fact, which releases the transfer
+	fact, err := transferErc20Fact(params.EthAddress, lp.tokenAmount, result.Erc20Address, result.L2Nonce)
+	if err != nil {
+		return nil, err
+	}
+	condition, err := factCondition(factRegistry, fact)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := c.signLpTransfer(&lp, result, condition)
+	if err != nil {
+		return nil, err
+	}
+	result.Fact = "0x" + hex.EncodeToString(fact)
+	result.FactRegistryAddress = factRegistry
+
+	resp, err := c.CreateFastWithdraw(ctx, CreateFastWithdrawParams{
+		CoinId:               params.CoinId,
+		Amount:               result.Amount,
+		EthAddress:           params.EthAddress,
+		Erc20Address:         result.Erc20Address,
+		LpAccountId:          result.LpAccountId,
+		ClientFastWithdrawId: result.ClientWithdrawId,
+		ExpireTime:           strconv.FormatInt(result.L2ExpireTime, 10),
+		L2Signature:          signature,
+		Fee:                  result.Fee,
+		FactRegistryAddress:  result.FactRegistryAddress,
+		Fact:                 result.Fact,
+		ChainId:              params.ChainId,
+	})
+	if err != nil {
+		return result, err
+	}
+	data := resp.GetData()
+	result.WithdrawId = data.GetFastWithdrawId()
+	return result, nil
+}
+
+// CrossWithdraw withdraws to another chain through the cross withdraw liquidity
+// provider. It fetches the sign info, checks the amount against the maximum and
+// signs a transfer of amount plus fee to the provider.
+func (c *Client) CrossWithdraw(ctx context.Context, params CrossWithdrawParams, metadata openapi.MetaData) (*WithdrawResult, error) {
+	if params.ClientWithdrawId != "" {
+		ctx = internal.WithIdempotent(ctx)
+	}
+
+	signInfoResp, err := c.GetCrossWithdrawSignInfo(ctx, GetCrossWithdrawSignInfoParams{ChainId: params.ChainId, Amount: params.Amount})
+	if err != nil {
+		return nil, err
+	}
+	signInfo := signInfoResp.GetData()
+
+	lp := lpTransfer{
+		accountId: signInfo.GetLpAccountId(),
+		l2Key:     signInfo.GetCrossWithdrawL2Key(),
+		maxAmount: signInfo.GetCrossWithdrawMaxAmount(),
+		fee:       signInfo.GetFee(),
+	}
+	result, err := c.prepareLpTransfer(&params.WithdrawParams, metadata, &lp)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := c.signLpTransfer(&lp, result, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.CreateCrossWithdraw(ctx, CreateCrossWithdrawParams{
+		CoinId:                params.CoinId,
+		Amount:                result.Amount,
+		EthAddress:            params.EthAddress,
+		Erc20Address:          result.Erc20Address,
+		LpAccountId:           result.LpAccountId,
+		ClientCrossWithdrawId: result.ClientWithdrawId,
+		ExpireTime:            strconv.FormatInt(result.L2ExpireTime, 10),
+		L2Signature:           signature,
+		Fee:                   result.Fee,
+		ChainId:               params.ChainId,
+		MpcAddress:            params.MpcAddress,
+		MpcSignature:          params.MpcSignature,
+		MpcSignTime:           params.MpcSignTime,
+	})
+	if err != nil {
+		return result, err
+	}
+	data := resp.GetData()
+	result.WithdrawId = data.GetCrossWithdrawId()
+	return result, nil
+}
+
+// lpTransfer holds the liquidity provider side of a withdrawal
+type lpTransfer struct {
+	accountId string
+	l2Key     string
+	maxAmount string
+	fee       string
+
+	assetID     *big.Int // StarkEx asset ID of the coin
+	tokenAmount *big.Int // Amount in L1 token units
+	l2Amount    int64    // Amount plus fee in L2 units
+	expireHour  int64    // L2 expire time in hours
+}
+
+// prepareLpTransfer validates a withdrawal and resolves its amounts, token, nonce and expiry
+func (c *Client) prepareLpTransfer(params *WithdrawParams, metadata openapi.MetaData, lp *lpTransfer) (*WithdrawResult, error) {
+	if lp.accountId == "" || lp.l2Key == "" {
+		return nil, fmt.Errorf("liquidity provider account not available for chain %s", params.ChainId)
+	}
+
+	amount, err := decimal.NewFromString(params.Amount)
+	if err != nil || !amount.IsPositive() {
+		return nil, fmt.Errorf("invalid withdraw amount: %s", params.Amount)
+	}
+	fee := decimal.Zero
+	if lp.fee != "" {
+		if fee, err = decimal.NewFromString(lp.fee); err != nil || fee.IsNegative() {
+			return nil, fmt.Errorf("invalid withdraw fee: %s", lp.fee)
+		}
+	}
+	if lp.maxAmount != "" {
+		maxAmount, err := decimal.NewFromString(lp.maxAmount)
+		if err != nil {
+			return nil, fmt.Errorf("invalid max withdraw amount: %s", lp.maxAmount)
+		}
+		if amount.GreaterThan(maxAmount) {
+			return nil, fmt.Errorf("%w: %s > %s", ErrWithdrawAmountExceeded, amount, maxAmount)
+		}
+	}
+
+	erc20Address, decimals, err := withdrawToken(metadata, params.ChainId, params.Erc20Address)
+	if err != nil {
+		return nil, err
+	}
+	if lp.assetID, err = internal.CoinAssetID(metadata, params.CoinId); err != nil {
+		return nil, err
+	}
+	tokenAmount := amount.Shift(decimals)
+	if !tokenAmount.IsInteger() {
+		return nil, fmt.Errorf("withdraw amount %s exceeds the %d decimals of the token", amount, decimals)
+	}
+	lp.tokenAmount = tokenAmount.BigInt()
+	lp.l2Amount = amount.Add(fee).Shift(6).IntPart()
+
+	if params.ClientWithdrawId == "" {
+		params.ClientWithdrawId = internal.GenerateUUID()
+	}
+	expiry, err := internal.ResolveL2Expiry(time.Now(), params.ExpireTime, params.ExpireAfter)
+	if err != nil {
+		return nil, err
+	}
+	lp.expireHour = expiry.L2ExpireHour()
+
+	return &WithdrawResult{
+		ClientWithdrawId: params.ClientWithdrawId,
+		LpAccountId:      lp.accountId,
+		Amount:           amount.String(),
+		Fee:              fee.String(),
+		Erc20Address:     erc20Address,
+		L2Nonce:          internal.CalcNonce(params.ClientWithdrawId),
+		L2ExpireTime:     expiry.L2ExpireTime,
+	}, nil
+}
+
+// signLpTransfer signs the transfer to the liquidity provider, conditional on the
+// fact when condition is set
+func (c *Client) signLpTransfer(lp *lpTransfer, result *WithdrawResult, condition *big.Int) (string, error) {
+	receiverPublicKey, ok := new(big.Int).SetString(strings.TrimPrefix(lp.l2Key, "0x"), 16)
+	if !ok {
+		return "", fmt.Errorf("invalid liquidity provider L2 key: %s", lp.l2Key)
+	}
+	receiverPositionId, err := strconv.ParseInt(lp.accountId, 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid liquidity provider account ID: %w", err)
+	}
+
+	// Position IDs are account IDs, the fee is paid within the amount
+	senderPositionId := c.GetAccountID()
+	var msgHash []byte
+	if condition != nil {
+		msgHash = internal.CalcConditionalTransferHash(lp.assetID, big.NewInt(0), receiverPublicKey, condition,
+			senderPositionId, receiverPositionId, senderPositionId, result.L2Nonce, lp.l2Amount, 0, lp.expireHour)
+	} else {
+		msgHash = internal.CalcTransferHash(lp.assetID, big.NewInt(0), receiverPublicKey,
+			senderPositionId, receiverPositionId, senderPositionId, result.L2Nonce, lp.l2Amount, 0, lp.expireHour)
+	}
+
+	signature, err := c.Sign(msgHash)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign withdraw transfer hash: %w", err)
+	}
+	return fmt.Sprintf("%s%s%s", signature.R, signature.S, signature.V), nil
+}
+
+// withdrawToken returns the L1 token address and decimals of a withdrawal
+func withdrawToken(metadata openapi.MetaData, chainId, erc20Address string) (string, int32, error) {
+	multiChain := metadata.GetMultiChain()
+	for _, chain := range multiChain.GetChainList() {
+		if chain.GetChainId() != chainId {
+			continue
+		}
+		for _, token := range chain.GetTokenList() {
+			if erc20Address != "" && !strings.EqualFold(token.GetTokenAddress(), erc20Address) {
+				continue
+			}
+			if erc20Address == "" && (!token.GetWithdrawEnable() || token.GetPullOff()) {
+				continue
+			}
+			decimals, err := strconv.ParseInt(token.GetDecimals(), 10, 32)
+			if err != nil {
+				return "", 0, fmt.Errorf("invalid decimals of token %s: %s", token.GetTokenAddress(), token.GetDecimals())
+			}
+			return token.GetTokenAddress(), int32(decimals), nil
+		}
+		return "", 0, fmt.Errorf("no withdrawable token %s on chain %s", erc20Address, chainId)
+	}
+	return "", 0, fmt.Errorf("chain not found: %s", chainId)
+}
+
+// transferErc20Fact returns the L1 fact of an ERC20 transfer,
+// keccak256(recipient, amount, token, salt) packed as address, uint256, address, uint256
+func transferErc20Fact(recipient string, amount *big.Int, token string, salt int64) ([]byte, error) {
+	recipientBytes, err := addressBytes(recipient)
+	if err != nil {
+		return nil, err
+	}
+	tokenBytes, err := addressBytes(token)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write(recipientBytes)
+	hash.Write(amount.FillBytes(make([]byte, 32)))
+	hash.Write(tokenBytes)
+	hash.Write(big.NewInt(salt).FillBytes(make([]byte, 32)))
+	return hash.Sum(nil), nil
+}
+
+// factCondition returns the condition of a transfer released by a fact,
+// the lower 250 bits of keccak256(factRegistry, fact)
+func factCondition(factRegistry string, fact []byte) (*big.Int, error) {
+	registryBytes, err := addressBytes(factRegistry)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write(registryBytes)
+	hash.Write(fact)
+	condition := new(big.Int).SetBytes(hash.Sum(nil))
+	mask := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 250), big.NewInt(1))
+	return condition.And(condition, mask), nil
+}
+
+// addressBytes decodes a 20 byte L1 address
+func addressBytes(address string) ([]byte, error) {
+	b, err := hex.DecodeString(strings.TrimPrefix(address, "0x"))
+	if err != nil || len(b) != 20 {
+		return nil, fmt.Errorf("invalid address: %s", address)
+	}
+	return b, nil
+}
@@ -8,18 +8,25 @@ import (
 	"io"
 	"net/http"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	openapi "github.com/edgex-Tech/edgex-golang-sdk/openapi"
 	"github.com/edgex-Tech/edgex-golang-sdk/sdk/account"
 	"github.com/edgex-Tech/edgex-golang-sdk/sdk/asset"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/deposit"
 	"github.com/edgex-Tech/edgex-golang-sdk/sdk/funding"
 	"github.com/edgex-Tech/edgex-golang-sdk/sdk/internal"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/market"
 	"github.com/edgex-Tech/edgex-golang-sdk/sdk/metadata"
 	"github.com/edgex-Tech/edgex-golang-sdk/sdk/order"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/orderbook"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/pager"
 	"github.com/edgex-Tech/edgex-golang-sdk/sdk/quote"
 	"github.com/edgex-Tech/edgex-golang-sdk/sdk/transfer"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/withdraw"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/ws"
 	"github.com/shopspring/decimal"
 	"golang.org/x/crypto/sha3"
 )
@@ -34,6 +41,15 @@ type Client struct {
 	Funding  *funding.Client
 	Transfer *transfer.Client
 	Asset    *asset.Client
+	Deposit  *deposit.Client
+	Withdraw *withdraw.Client
+
+	// MetadataCache caches the exchange metadata used for order and transfer signing
+	MetadataCache *metadata.Cache
+
+	rateLimiter      *rateLimitTransport
+	rateLimitConfig  *RateLimitConfig
+	batchConcurrency int
 }
 
 // ClientConfig holds the configuration for creating a new Client
@@ -41,6 +57,15 @@ type ClientConfig struct {
 	BaseURL     string
 	AccountID   int64
 	StarkPriKey string
+	RetryPolicy *RetryPolicy     // Retry policy for REST requests, nil disables retries
+	RateLimit   *RateLimitConfig // Client side rate limits, nil disables rate limiting
+
+	MetadataCacheTTL        time.Duration // Time-to-live of cached metadata, 0 uses metadata.DefaultCacheTTL
+	MetadataRefreshInterval time.Duration // Background metadata refresh interval, 0 disables background refresh
+
+	BatchConcurrency int // Maximum orders in flight in CreateOrders, 0 uses order.DefaultBatchConcurrency
+
+	OnFeeRateError func(err error) // Called when orders fall back to the contract default fee rates, may be nil
 }
 
 // NewClient creates a new EdgeX SDK client
@@ -63,29 +88,64 @@ func NewClient(cfg *ClientConfig) (*Client, error) {
 	}
 
 	// Create transport for request interception
-	transport := http.DefaultTransport
+	var transport http.RoundTripper = &requestInterceptor{
+		transport:      http.DefaultTransport,
+		internalClient: internalClient,
+		baseURL:        cfg.BaseURL,
+	}
+	var rateLimiter *rateLimitTransport
+	if cfg.RateLimit != nil {
+		rateLimiter = newRateLimitTransport(transport, cfg.RateLimit)
+		transport = rateLimiter
+	}
+	if cfg.RetryPolicy != nil {
+		transport = &retryTransport{
+			transport: transport,
+			policy:    cfg.RetryPolicy,
+		}
+	}
 	openapiConfig.HTTPClient = &http.Client{
-		Transport: &requestInterceptor{
-			transport:      transport,
-			internalClient: internalClient,
-			baseURL:        cfg.BaseURL,
-		},
+		Transport: transport,
 	}
 
 	openapiClient := openapi.NewAPIClient(openapiConfig)
 
+	metadataClient := metadata.NewClient(internalClient, openapiClient)
+	metadataCache := metadata.NewCache(metadataClient, cfg.MetadataCacheTTL)
+	if cfg.MetadataRefreshInterval > 0 {
+		metadataCache.StartAutoRefresh(cfg.MetadataRefreshInterval)
+	}
+
+	orderClient := order.NewClient(internalClient, openapiClient)
+	if cfg.OnFeeRateError != nil {
+		orderClient.SetFeeRateErrorHandler(cfg.OnFeeRateError)
+	}
+
 	return &Client{
 		Client:   internalClient,
-		Order:    order.NewClient(internalClient, openapiClient),
-		Metadata: metadata.NewClient(internalClient, openapiClient),
+		Order:    orderClient,
+		Metadata: metadataClient,
 		Account:  account.NewClient(internalClient, openapiClient),
 		Quote:    quote.NewClient(internalClient, openapiClient),
 		Funding:  funding.NewClient(internalClient, openapiClient),
 		Transfer: transfer.NewClient(internalClient, openapiClient),
 		Asset:    asset.NewClient(internalClient, openapiClient),
+		Deposit:  deposit.NewClient(internalClient, openapiClient),
+		Withdraw: withdraw.NewClient(internalClient, openapiClient),
+
+		MetadataCache: metadataCache,
+
+		rateLimiter:      rateLimiter,
+		rateLimitConfig:  cfg.RateLimit,
+		batchConcurrency: cfg.BatchConcurrency,
 	}, nil
 }
 
+// Close stops the background tasks of the client
+func (c *Client) Close() {
+	c.MetadataCache.Stop()
+}
+
 // requestInterceptor implements http.RoundTripper to intercept requests
 type requestInterceptor struct {
 	transport      http.RoundTripper
@@ -161,12 +221,52 @@ func (c *Client) GetServerTime(ctx context.Context) (*openapi.ResultGetServerTim
 // CreateOrder creates a new order with the given parameters
 func (c *Client) CreateOrder(ctx context.Context, params *order.CreateOrderParams) (*openapi.ResultCreateOrder, error) {
 	// Get metadata first
-	metadata, err := c.GetMetaData(ctx)
+	metadata, err := c.MetadataCache.Get(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get metadata: %w", err)
 	}
 
-	return c.Order.CreateOrder(ctx, params, metadata.GetData())
+	return c.Order.CreateOrder(ctx, params, *metadata)
+}
+
+// CreateOrders creates several orders concurrently and returns the result of each order
+func (c *Client) CreateOrders(ctx context.Context, params []order.CreateOrderParams) ([]order.CreateOrderResult, error) {
+	metadata, err := c.MetadataCache.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get metadata: %w", err)
+	}
+
+	return c.Order.CreateOrders(ctx, params, *metadata, c.batchConcurrency)
+}
+
+// CreateBracketOrder creates an entry order with attached take-profit and stop-loss legs
+func (c *Client) CreateBracketOrder(ctx context.Context, params *order.BracketOrderParams) (*openapi.ResultCreateOrder, error) {
+	metadata, err := c.MetadataCache.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get metadata: %w", err)
+	}
+
+	return c.Order.CreateBracketOrder(ctx, params, *metadata)
+}
+
+// CreatePositionTpSl places take-profit and stop-loss orders for an open position
+func (c *Client) CreatePositionTpSl(ctx context.Context, params *order.PositionTpSlParams) (*order.PositionTpSlResult, error) {
+	metadata, err := c.MetadataCache.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get metadata: %w", err)
+	}
+
+	return c.Order.CreatePositionTpSl(ctx, params, *metadata)
+}
+
+// GetFeeRates gets the taker and maker fee rates the account pays in a contract
+func (c *Client) GetFeeRates(ctx context.Context, contractID string) (*market.FeeRates, error) {
+	metadata, err := c.MetadataCache.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get metadata: %w", err)
+	}
+
+	return c.Order.GetFeeRates(ctx, contractID, *metadata)
 }
 
 // GetMaxOrderSize gets the maximum order size for a given contract and price
@@ -180,16 +280,93 @@ func (c *Client) CancelOrder(ctx context.Context, params *order.CancelOrderParam
 	return c.Order.CancelOrder(ctx, params)
 }
 
+// CancelOrders cancels several orders by order ID and client order ID
+func (c *Client) CancelOrders(ctx context.Context, params *order.CancelOrdersParams) (*order.CancelOrdersResult, error) {
+	return c.Order.CancelOrders(ctx, params)
+}
+
+// ReplaceOrder cancels an order and places a new one at the given price and size
+func (c *Client) ReplaceOrder(ctx context.Context, params *order.ReplaceOrderParams) (*order.ReplaceOrderResult, error) {
+	metadata, err := c.MetadataCache.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get metadata: %w", err)
+	}
+
+	return c.Order.ReplaceOrder(ctx, params, *metadata)
+}
+
+// CancelAll cancels all active orders matching the filters, nil cancels all orders
+func (c *Client) CancelAll(ctx context.Context, params *order.CancelAllParams) (*openapi.ResultCancelOrder, error) {
+	return c.Order.CancelAll(ctx, params)
+}
+
+// StartDeadManSwitch starts a dead man's switch that cancels all orders on missed
+// heartbeats. When a connected private WebSocket client is given, the switch also
+// fires if it stays disconnected longer than cfg.DisconnectTimeout.
+func (c *Client) StartDeadManSwitch(cfg order.DeadManSwitchConfig, privateWS *ws.Client) *order.DeadManSwitch {
+	d := c.Order.NewDeadManSwitch(cfg)
+	if privateWS != nil {
+		privateWS.OnConnect(func() {
+			d.SetConnected(true)
+		})
+		privateWS.OnDisconnect(func(error) {
+			d.SetConnected(false)
+		})
+	}
+	d.Start()
+	return d
+}
+
 // GetActiveOrders gets active orders with pagination and filters
 func (c *Client) GetActiveOrders(ctx context.Context, params *order.GetActiveOrderParams) (*openapi.ResultPageDataOrder, error) {
 	return c.Order.GetActiveOrders(ctx, params)
 }
 
+// ActiveOrdersPager returns a pager over all active orders matching the filters
+func (c *Client) ActiveOrdersPager(params *order.GetActiveOrderParams, opts *pager.Options) *pager.Pager[openapi.Order] {
+	return c.Order.ActiveOrdersPager(params, opts)
+}
+
+// GetOrder gets an order by order ID or client order ID, active or historical
+func (c *Client) GetOrder(ctx context.Context, params *order.GetOrderParams) (*openapi.ResultListOrder, error) {
+	return c.Order.GetOrder(ctx, params)
+}
+
+// GetHistoryOrderPage gets historical orders with pagination and filters
+func (c *Client) GetHistoryOrderPage(ctx context.Context, params *order.GetHistoryOrderParams) (*openapi.ResultPageDataOrder, error) {
+	return c.Order.GetHistoryOrderPage(ctx, params)
+}
+
+// HistoryOrdersPager returns a pager over all historical orders matching the filters
+func (c *Client) HistoryOrdersPager(params *order.GetHistoryOrderParams, opts *pager.Options) *pager.Pager[openapi.Order] {
+	return c.Order.HistoryOrdersPager(params, opts)
+}
+
+// GetHistoryOrderById gets historical orders by order IDs
+func (c *Client) GetHistoryOrderById(ctx context.Context, orderIDs []string) (*openapi.ResultListOrder, error) {
+	return c.Order.GetHistoryOrderById(ctx, orderIDs)
+}
+
+// GetHistoryOrderByClientOrderId gets historical orders by client order IDs
+func (c *Client) GetHistoryOrderByClientOrderId(ctx context.Context, clientOrderIDs []string) (*openapi.ResultListOrder, error) {
+	return c.Order.GetHistoryOrderByClientOrderId(ctx, clientOrderIDs)
+}
+
+// GetHistoryOrderFillTransactionById gets historical order fill transactions by IDs
+func (c *Client) GetHistoryOrderFillTransactionById(ctx context.Context, fillTransactionIDs []string) (*openapi.ResultListOrderFillTransaction, error) {
+	return c.Order.GetHistoryOrderFillTransactionById(ctx, fillTransactionIDs)
+}
+
 // GetOrderFillTransactions gets order fill transactions with pagination and filters
 func (c *Client) GetOrderFillTransactions(ctx context.Context, params *order.OrderFillTransactionParams) (*openapi.ResultPageDataOrderFillTransaction, error) {
 	return c.Order.GetOrderFillTransactions(ctx, params)
 }
 
+// OrderFillTransactionsPager returns a pager over all order fill transactions matching the filters
+func (c *Client) OrderFillTransactionsPager(params *order.OrderFillTransactionParams, opts *pager.Options) *pager.Pager[openapi.OrderFillTransaction] {
+	return c.Order.OrderFillTransactionsPager(params, opts)
+}
+
 // GetAccountAsset gets the account asset information
 func (c *Client) GetAccountAsset(ctx context.Context) (*openapi.ResultGetAccountAsset, error) {
 	return c.Account.GetAccountAsset(ctx)
@@ -205,21 +382,41 @@ func (c *Client) GetPositionTransactionPage(ctx context.Context, params account.
 	return c.Account.GetPositionTransactionPage(ctx, params)
 }
 
+// PositionTransactionsPager returns a pager over all position transactions matching the filters
+func (c *Client) PositionTransactionsPager(params account.GetPositionTransactionPageParams, opts *pager.Options) *pager.Pager[openapi.PositionTransaction] {
+	return c.Account.PositionTransactionsPager(params, opts)
+}
+
 // GetCollateralTransactionPage gets the collateral transactions with pagination
 func (c *Client) GetCollateralTransactionPage(ctx context.Context, params account.GetCollateralTransactionPageParams) (*openapi.ResultPageDataCollateralTransaction, error) {
 	return c.Account.GetCollateralTransactionPage(ctx, params)
 }
 
+// CollateralTransactionsPager returns a pager over all collateral transactions matching the filters
+func (c *Client) CollateralTransactionsPager(params account.GetCollateralTransactionPageParams, opts *pager.Options) *pager.Pager[openapi.CollateralTransaction] {
+	return c.Account.CollateralTransactionsPager(params, opts)
+}
+
 // GetPositionTermPage gets the position terms with pagination
 func (c *Client) GetPositionTermPage(ctx context.Context, params account.GetPositionTermPageParams) (*openapi.ResultPageDataPositionTerm, error) {
 	return c.Account.GetPositionTermPage(ctx, params)
 }
 
+// PositionTermsPager returns a pager over all position terms matching the filters
+func (c *Client) PositionTermsPager(params account.GetPositionTermPageParams, opts *pager.Options) *pager.Pager[openapi.PositionTerm] {
+	return c.Account.PositionTermsPager(params, opts)
+}
+
 // GetAccountByID gets account information by ID
 func (c *Client) GetAccountByID(ctx context.Context) (*openapi.ResultAccount, error) {
 	return c.Account.GetAccountByID(ctx)
 }
 
+// GetAccountByAccountID gets account information of another account of the user
+func (c *Client) GetAccountByAccountID(ctx context.Context, accountID int64) (*openapi.ResultAccount, error) {
+	return c.Account.GetAccountByAccountID(ctx, accountID)
+}
+
 // GetAccountDeleverageLight gets account deleverage light information
 func (c *Client) GetAccountDeleverageLight(ctx context.Context) (*openapi.ResultGetAccountDeleverageLight, error) {
 	return c.Account.GetAccountDeleverageLight(ctx)
@@ -230,6 +427,11 @@ func (c *Client) GetAccountAssetSnapshotPage(ctx context.Context, params account
 	return c.Account.GetAccountAssetSnapshotPage(ctx, params)
 }
 
+// AccountAssetSnapshotsPager returns a pager over all account asset snapshots matching the filters
+func (c *Client) AccountAssetSnapshotsPager(params account.GetAccountAssetSnapshotPageParams, opts *pager.Options) *pager.Pager[openapi.AccountAssetSnapshot] {
+	return c.Account.AccountAssetSnapshotsPager(params, opts)
+}
+
 // GetPositionTransactionByID gets position transactions by IDs
 func (c *Client) GetPositionTransactionByID(ctx context.Context, transactionIDs []string) (*openapi.ResultListPositionTransaction, error) {
 	return c.Account.GetPositionTransactionByID(ctx, transactionIDs)
@@ -260,11 +462,47 @@ func (c *Client) GetOrderBookDepth(ctx context.Context, params quote.GetOrderBoo
 	return c.Quote.GetOrderBookDepth(ctx, params)
 }
 
+// NewOrderBook creates a local order book of a contract that resyncs through the client.
+// Feed it with Book.Subscribe on a public WebSocket connection.
+func (c *Client) NewOrderBook(contractId string, opts *orderbook.Options) *orderbook.Book {
+	return orderbook.New(contractId, c, opts)
+}
+
 // GetMultiContractKLine gets the K-line data for multiple contracts
 func (c *Client) GetMultiContractKLine(ctx context.Context, params quote.GetMultiContractKLineParams) (*openapi.ResultListContractKline, error) {
 	return c.Quote.GetMultiContractKLine(ctx, params)
 }
 
+// CreateDeposit creates a new deposit order
+func (c *Client) CreateDeposit(ctx context.Context, params deposit.CreateDepositParams) (*openapi.ResultCreateDeposit, error) {
+	return c.Deposit.CreateDeposit(ctx, params)
+}
+
+// GetActiveDeposit gets the active deposits with pagination
+func (c *Client) GetActiveDeposit(ctx context.Context, params deposit.GetActiveDepositParams) (*openapi.ResultPageDataDeposit, error) {
+	return c.Deposit.GetActiveDeposit(ctx, params)
+}
+
+// ActiveDepositsPager returns a pager over all active deposits
+func (c *Client) ActiveDepositsPager(opts *pager.Options) *pager.Pager[openapi.Deposit] {
+	return c.Deposit.ActiveDepositsPager(opts)
+}
+
+// GetDepositById gets deposits by deposit IDs
+func (c *Client) GetDepositById(ctx context.Context, depositIDs []string) (*openapi.ResultListDeposit, error) {
+	return c.Deposit.GetDepositById(ctx, depositIDs)
+}
+
+// GetDepositByClientDepositId gets deposits by client deposit IDs
+func (c *Client) GetDepositByClientDepositId(ctx context.Context, clientDepositIDs []string) (*openapi.ResultListDeposit, error) {
+	return c.Deposit.GetDepositByClientDepositId(ctx, clientDepositIDs)
+}
+
+// WaitForDeposit polls a deposit until it reaches a final status
+func (c *Client) WaitForDeposit(ctx context.Context, depositID string, params *deposit.WaitForDepositParams) (*openapi.Deposit, error) {
+	return c.Deposit.WaitForDeposit(ctx, depositID, params)
+}
+
 // GetTransferOutById gets a transfer out record by ID
 func (c *Client) GetTransferOutById(ctx context.Context, params transfer.GetTransferOutByIdParams) (*openapi.ResultListTransferOut, error) {
 	return c.Transfer.GetTransferOutById(ctx, params)
@@ -283,12 +521,180 @@ func (c *Client) GetWithdrawAvailableAmount(ctx context.Context, params transfer
 // CreateTransferOut creates a new transfer out order
 func (c *Client) CreateTransferOut(ctx context.Context, params transfer.CreateTransferOutParams) (*openapi.ResultCreateTransferOut, error) {
 	// Get metadata first
-	metadata, err := c.GetMetaData(ctx)
+	metadata, err := c.MetadataCache.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get metadata: %w", err)
+	}
+
+	return c.Transfer.CreateTransferOut(ctx, params, *metadata)
+}
+
+// InternalTransfer transfers to another account of the user, such as a sub-account.
+// The receiver L2 key is looked up with GetAccountByID when params.ReceiverL2Key is empty.
+func (c *Client) InternalTransfer(ctx context.Context, params transfer.CreateTransferOutParams) (*openapi.ResultCreateTransferOut, error) {
+	if params.ReceiverL2Key == "" {
+		receiverAccountId, err := strconv.ParseInt(params.ReceiverAccountId, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid receiver account ID: %w", err)
+		}
+		resp, err := c.Account.GetAccountByAccountID(ctx, receiverAccountId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get receiver account: %w", err)
+		}
+		account := resp.GetData()
+		if account.GetL2Key() == "" {
+			return nil, fmt.Errorf("receiver account %s has no L2 key", params.ReceiverAccountId)
+		}
+		params.ReceiverL2Key = account.GetL2Key()
+	}
+
+	return c.CreateTransferOut(ctx, params)
+}
+
+// GetActiveTransferOut gets the active transfer out records of the account with pagination
+func (c *Client) GetActiveTransferOut(ctx context.Context, params transfer.GetActiveTransferOutParams) (*openapi.ResultPageDataTransferOut, error) {
+	return c.Transfer.GetActiveTransferOut(ctx, params)
+}
+
+// GetActiveTransferIn gets the active transfer in records of the account with pagination
+func (c *Client) GetActiveTransferIn(ctx context.Context, params transfer.GetActiveTransferInParams) (*openapi.ResultPageDataTransferIn, error) {
+	return c.Transfer.GetActiveTransferIn(ctx, params)
+}
+
+// ActiveTransferOutsPager returns a pager over all active transfer out records matching the filters
+func (c *Client) ActiveTransferOutsPager(filter transfer.TransferFilterParams, opts *pager.Options) *pager.Pager[openapi.TransferOut] {
+	return c.Transfer.ActiveTransferOutsPager(filter, opts)
+}
+
+// ActiveTransferInsPager returns a pager over all active transfer in records matching the filters
+func (c *Client) ActiveTransferInsPager(filter transfer.TransferFilterParams, opts *pager.Options) *pager.Pager[openapi.TransferIn] {
+	return c.Transfer.ActiveTransferInsPager(filter, opts)
+}
+
+// WaitForTransfer polls a transfer out until it reaches a final status
+func (c *Client) WaitForTransfer(ctx context.Context, transferOutId string, params *transfer.WaitForTransferParams) (*openapi.TransferOut, error) {
+	return c.Transfer.WaitForTransfer(ctx, transferOutId, params)
+}
+
+// CreateNormalWithdraw creates a signed normal withdrawal to an L1 address
+func (c *Client) CreateNormalWithdraw(ctx context.Context, params asset.CreateNormalWithdrawParams) (*openapi.ResultCreateNormalWithdraw, error) {
+	metadata, err := c.MetadataCache.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get metadata: %w", err)
+	}
+
+	return c.Asset.CreateNormalWithdraw(ctx, params, *metadata)
+}
+
+// FastWithdraw withdraws to an L1 address through the fast withdraw liquidity provider
+func (c *Client) FastWithdraw(ctx context.Context, params asset.WithdrawParams) (*asset.WithdrawResult, error) {
+	metadata, err := c.MetadataCache.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get metadata: %w", err)
+	}
+
+	return c.Asset.FastWithdraw(ctx, params, *metadata)
+}
+
+// CrossWithdraw withdraws to another chain through the cross withdraw liquidity provider
+func (c *Client) CrossWithdraw(ctx context.Context, params asset.CrossWithdrawParams) (*asset.WithdrawResult, error) {
+	metadata, err := c.MetadataCache.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get metadata: %w", err)
+	}
+
+	return c.Asset.CrossWithdraw(ctx, params, *metadata)
+}
+
+// CreateFastWithdraw creates a fast withdrawal order from a precomputed fact and signature
+func (c *Client) CreateFastWithdraw(ctx context.Context, params asset.CreateFastWithdrawParams) (*openapi.ResultCreateFastWithdraw, error) {
+	return c.Asset.CreateFastWithdraw(ctx, params)
+}
+
+// WaitForFastWithdraw polls a fast withdrawal until it succeeds or fails
+func (c *Client) WaitForFastWithdraw(ctx context.Context, fastWithdrawId string, params *asset.WaitForWithdrawParams) (*openapi.FastWithdraw, error) {
+	return c.Asset.WaitForFastWithdraw(ctx, fastWithdrawId, params)
+}
+
+// WaitForCrossWithdraw polls a cross-chain withdrawal until it succeeds or fails
+func (c *Client) WaitForCrossWithdraw(ctx context.Context, crossWithdrawId string, params *asset.WaitForWithdrawParams) (*openapi.CrossWithdraw, error) {
+	return c.Asset.WaitForCrossWithdraw(ctx, crossWithdrawId, params)
+}
+
+// CreateWithdraw creates a signed withdrawal to the L1 address registered with the stark key
+func (c *Client) CreateWithdraw(ctx context.Context, params withdraw.CreateWithdrawParams) (*openapi.ResultCreateWithdraw, error) {
+	metadata, err := c.MetadataCache.Get(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get metadata: %w", err)
 	}
 
-	return c.Transfer.CreateTransferOut(ctx, params, metadata.GetData())
+	return c.Withdraw.CreateWithdraw(ctx, params, *metadata)
+}
+
+// GetActiveWithdraw gets the active withdrawals of the account with pagination
+func (c *Client) GetActiveWithdraw(ctx context.Context, params withdraw.GetActiveWithdrawParams) (*openapi.ResultPageDataWithdraw, error) {
+	return c.Withdraw.GetActiveWithdraw(ctx, params)
+}
+
+// ActiveWithdrawsPager returns a pager over all active withdrawals matching the filters of params
+func (c *Client) ActiveWithdrawsPager(params withdraw.GetActiveWithdrawParams, opts *pager.Options) *pager.Pager[openapi.Withdraw] {
+	return c.Withdraw.ActiveWithdrawsPager(params, opts)
+}
+
+// GetWithdrawById gets withdrawals by withdraw IDs
+func (c *Client) GetWithdrawById(ctx context.Context, withdrawIds []string) (*openapi.ResultListWithdraw, error) {
+	return c.Withdraw.GetWithdrawById(ctx, withdrawIds)
+}
+
+// GetWithdrawByClientWithdrawId gets withdrawals by client withdraw IDs
+func (c *Client) GetWithdrawByClientWithdrawId(ctx context.Context, clientWithdrawIds []string) (*openapi.ResultListWithdraw, error) {
+	return c.Withdraw.GetWithdrawByClientWithdrawId(ctx, clientWithdrawIds)
+}
+
+// GetWithdrawal gets a withdrawal of any kind by ID in the common withdrawal shape
+func (c *Client) GetWithdrawal(ctx context.Context, kind withdraw.Kind, id string) (*withdraw.Withdrawal, error) {
+	var withdrawals []withdraw.Withdrawal
+	switch kind {
+	case withdraw.KindWithdraw:
+		resp, err := c.Withdraw.GetWithdrawById(ctx, []string{id})
+		if err != nil {
+			return nil, err
+		}
+		for _, w := range resp.GetData() {
+			withdrawals = append(withdrawals, withdraw.FromWithdraw(w))
+		}
+	case withdraw.KindNormalWithdraw:
+		resp, err := c.Asset.GetNormalWithdrawById(ctx, asset.GetNormalWithdrawByIdParams{NormalWithdrawIdList: id})
+		if err != nil {
+			return nil, err
+		}
+		for _, w := range resp.GetData() {
+			withdrawals = append(withdrawals, withdraw.FromNormalWithdraw(w))
+		}
+	case withdraw.KindFastWithdraw:
+		resp, err := c.Asset.GetFastWithdrawById(ctx, asset.GetFastWithdrawByIdParams{FastWithdrawIdList: id})
+		if err != nil {
+			return nil, err
+		}
+		for _, w := range resp.GetData() {
+			withdrawals = append(withdrawals, withdraw.FromFastWithdraw(w))
+		}
+	case withdraw.KindCrossWithdraw:
+		resp, err := c.Asset.GetCrossWithdrawById(ctx, asset.GetCrossWithdrawByIdParams{CrossWithdrawIdList: id})
+		if err != nil {
+			return nil, err
+		}
+		for _, w := range resp.GetData() {
+			withdrawals = append(withdrawals, withdraw.FromCrossWithdraw(w))
+		}
+	default:
+		return nil, fmt.Errorf("unknown withdraw kind: %s", kind)
+	}
+
+	if len(withdrawals) == 0 {
+		return nil, fmt.Errorf("%s %s not found", strings.ToLower(string(kind)), id)
+	}
+	return &withdrawals[0], nil
 }
 
 // UpdateLeverageSetting updates the account leverage settings
@@ -311,29 +717,16 @@ func (c *Client) CreateLimitOrder(ctx context.Context, contractId, size, price,
 
 // CreateMarketOrder creates a new market order with the given parameters
 func (c *Client) CreateMarketOrder(ctx context.Context, contractId, size, side string, clientOrderId *string) (*openapi.ResultCreateOrder, error) {
-	// Get metadata for contract info
-	metadata, err := c.GetMetaData(ctx)
+	// Get market rules from the metadata cache
+	mkt, err := c.MetadataCache.GetMarket(ctx, contractId)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get metadata: %w", err)
-	}
-
-	// Find the contract
-	var contract *openapi.Contract
-	contractList := metadata.Data.ContractList
-	for _, c := range contractList {
-		if *c.ContractId == contractId {
-			contract = &c
-			break
-		}
-	}
-	if contract == nil {
-		return nil, fmt.Errorf("contract not found: %s", contractId)
+		return nil, err
 	}
 
 	// Calculate price based on side
 	var price string
 	if side == order.OrderSideBuy {
-		// For buy orders: oracle_price * 10, rounded to price precision
+		// For buy orders: oracle_price * 10, rounded to tick size
 		quote, err := c.Get24HourQuote(ctx, contractId)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get 24-hour quotes: %w", err)
@@ -343,15 +736,10 @@ func (c *Client) CreateMarketOrder(ctx context.Context, contractId, size, side s
 			return nil, fmt.Errorf("invalid oracle price: %s", quote.GetData()[0].GetOraclePrice())
 		}
 		multiplier := decimal.NewFromInt(10)
-		tickSize, err := decimal.NewFromString(*contract.TickSize)
-		if err != nil {
-			return nil, fmt.Errorf("invalid tick size: %s", *contract.TickSize)
-		}
-		precision := int32(tickSize.Exponent())
-		price = oraclePrice.Mul(multiplier).Round(precision).String()
+		price = mkt.RoundPrice(oraclePrice.Mul(multiplier), side).String()
 	} else {
 		// For sell orders: use tick size
-		price = *contract.TickSize
+		price = mkt.TickSize.String()
 	}
 
 	params := &order.CreateOrderParams{
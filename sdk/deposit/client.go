@@ -0,0 +1,214 @@
+package deposit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	openapi "github.com/edgex-Tech/edgex-golang-sdk/openapi"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/internal"
+)
+
+// Client represents the deposit client
+type Client struct {
+	*internal.Client
+	openapiClient *openapi.APIClient
+}
+
+// NewClient creates a new deposit client
+func NewClient(client *internal.Client, openapiClient *openapi.APIClient) *Client {
+	return &Client{
+		Client:        client,
+		openapiClient: openapiClient,
+	}
+}
+
+// CreateDepositParams represents the parameters for CreateDeposit
+type CreateDepositParams struct {
+	CoinId          string
+	Amount          string
+	EthAddress      string        // Address the deposit was sent from
+	Erc20Address    string        // Contract address of the deposited coin
+	ClientDepositId string        // Client defined ID, generated if empty
+	L1Tx            *openapi.L1Tx // L1 transaction of the deposit
+	RiskSignature   string
+	L2Key           string // Receiving L2 key
+	ExtraType       string
+	ExtraDataJson   string
+}
+
+// CreateDeposit creates a new deposit order
+func (c *Client) CreateDeposit(ctx context.Context, params CreateDepositParams) (*openapi.ResultCreateDeposit, error) {
+	if params.CoinId == "" || params.Amount == "" {
+		return nil, fmt.Errorf("coinId and amount are required")
+	}
+
+	// A caller supplied ID is deduplicated by the server, so the request can be retried
+	if params.ClientDepositId == "" {
+		params.ClientDepositId = internal.GenerateUUID()
+	} else {
+		ctx = internal.WithIdempotent(ctx)
+	}
+
+	param := openapi.CreateDepositParam{}
+	param.SetAccountId(strconv.FormatInt(c.GetAccountID(), 10))
+	param.SetCoinId(params.CoinId)
+	param.SetAmount(params.Amount)
+	param.SetClientDepositId(params.ClientDepositId)
+	if params.EthAddress != "" {
+		param.SetEthAddress(params.EthAddress)
+	}
+	if params.Erc20Address != "" {
+		param.SetErc20Address(params.Erc20Address)
+	}
+	if params.L1Tx != nil {
+		param.SetL1Tx(*params.L1Tx)
+	}
+	if params.RiskSignature != "" {
+		param.SetRiskSignature(params.RiskSignature)
+	}
+	if params.L2Key != "" {
+		param.SetL2Key(params.L2Key)
+	}
+	if params.ExtraType != "" {
+		param.SetExtraType(params.ExtraType)
+	}
+	if params.ExtraDataJson != "" {
+		param.SetExtraDataJson(params.ExtraDataJson)
+	}
+
+	resp, httpResp, err := c.openapiClient.Class05DepositPrivateApiAPI.CreateDeposit(ctx).
+		CreateDepositParam(param).
+		Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create deposit: %w", internal.ParseError(err, httpResp))
+	}
+
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetActiveDepositParams represents the parameters for GetActiveDeposit
+type GetActiveDepositParams struct {
+	Size       string // Size of the page, must be greater than 0 and less than or equal to 100
+	OffsetData string // Offset data for pagination. Empty string gets the first page
+}
+
+// GetActiveDeposit gets the active deposits of the account with pagination
+func (c *Client) GetActiveDeposit(ctx context.Context, params GetActiveDepositParams) (*openapi.ResultPageDataDeposit, error) {
+	req := c.openapiClient.Class05DepositPrivateApiAPI.GetActiveDeposit(ctx).
+		AccountId(strconv.FormatInt(c.GetAccountID(), 10))
+
+	if params.Size != "" {
+		req = req.Size(params.Size)
+	}
+	if params.OffsetData != "" {
+		req = req.OffsetData(params.OffsetData)
+	}
+
+	resp, httpResp, err := req.Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active deposits: %w", internal.ParseError(err, httpResp))
+	}
+
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetDepositById gets deposits by deposit IDs
+func (c *Client) GetDepositById(ctx context.Context, depositIds []string) (*openapi.ResultListDeposit, error) {
+	if len(depositIds) == 0 {
+		return nil, fmt.Errorf("at least one depositId is required")
+	}
+
+	resp, httpResp, err := c.openapiClient.Class05DepositPrivateApiAPI.GetDepositById(ctx).
+		AccountId(strconv.FormatInt(c.GetAccountID(), 10)).
+		DepositIdList(internal.JoinStrings(depositIds)).
+		Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deposit by id: %w", internal.ParseError(err, httpResp))
+	}
+
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetDepositByClientDepositId gets deposits by client deposit IDs
+func (c *Client) GetDepositByClientDepositId(ctx context.Context, clientDepositIds []string) (*openapi.ResultListDeposit, error) {
+	if len(clientDepositIds) == 0 {
+		return nil, fmt.Errorf("at least one clientDepositId is required")
+	}
+
+	resp, httpResp, err := c.openapiClient.Class05DepositPrivateApiAPI.GetDepositByClientDepositId(ctx).
+		AccountId(strconv.FormatInt(c.GetAccountID(), 10)).
+		ClientDepositIdList(internal.JoinStrings(clientDepositIds)).
+		Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deposit by client deposit id: %w", internal.ParseError(err, httpResp))
+	}
+
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// RequestRelayerSignAndBroadcastParams represents the parameters for RequestRelayerSignAndBroadcast
+type RequestRelayerSignAndBroadcastParams struct {
+	Type         string
+	Amount       string
+	Owner        string // L1 address of the depositor
+	StarkKey     string // Receiving L2 key
+	PositionId   string // Receiving position ID, the account ID if empty
+	ChainId      string
+	Deadline     string // Deadline of the client signature
+	R            string // Client signature r
+	S            string // Client signature s
+	V            string // Client signature v
+	MpcSignature string
+}
+
+// RequestRelayerSignAndBroadcast asks the relayer to sign and broadcast a deposit on L1
+func (c *Client) RequestRelayerSignAndBroadcast(ctx context.Context, params RequestRelayerSignAndBroadcastParams) (*openapi.ResultResultRequestRelayerSignAndBroadcast, error) {
+	if params.PositionId == "" {
+		params.PositionId = strconv.FormatInt(c.GetAccountID(), 10)
+	}
+
+	param := openapi.RequestRelayerSignAndBroadcastParam{}
+	param.SetType(params.Type)
+	param.SetAmount(params.Amount)
+	param.SetOwner(params.Owner)
+	param.SetStarkKey(params.StarkKey)
+	param.SetPositionId(params.PositionId)
+	param.SetChainId(params.ChainId)
+	param.SetDeadline(params.Deadline)
+	param.SetR(params.R)
+	param.SetS(params.S)
+	param.SetV(params.V)
+	if params.MpcSignature != "" {
+		param.SetMpcSignature(params.MpcSignature)
+	}
+
+	resp, httpResp, err := c.openapiClient.Class05DepositPrivateApiAPI.RequestRelayerSignAndBroadcast(ctx).
+		RequestRelayerSignAndBroadcastParam(param).
+		Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to request relayer sign and broadcast: %w", internal.ParseError(err, httpResp))
+	}
+
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,21 @@
+package deposit
+
+import (
+	"context"
+	"strconv"
+
+	openapi "github.com/edgex-Tech/edgex-golang-sdk/openapi"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/pager"
+)
+
+// ActiveDepositsPager returns a pager over all active deposits of the account
+func (c *Client) ActiveDepositsPager(opts *pager.Options) *pager.Pager[openapi.Deposit] {
+	return pager.New(func(ctx context.Context, offset string, size int) ([]openapi.Deposit, string, error) {
+		resp, err := c.GetActiveDeposit(ctx, GetActiveDepositParams{Size: strconv.Itoa(size), OffsetData: offset})
+		if err != nil {
+			return nil, "", err
+		}
+		data := resp.GetData()
+		return data.GetDataList(), data.GetNextPageOffsetData(), nil
+	}, opts)
+}
@@ -0,0 +1,137 @@
+package deposit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	openapi "github.com/edgex-Tech/edgex-golang-sdk/openapi"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/internal"
+)
+
+// DepositStatus represents the status of a deposit. A deposit is first censored,
+// which credits the funds on success, and then approved or rejected on L2.
+type DepositStatus string
+
+const (
+	DepositStatusPendingCensoring DepositStatus = "PENDING_CENSORING"
+	DepositStatusCensorSuccess    DepositStatus = "SUCCESS_CENSOR_SUCCESS"
+	DepositStatusL2Approved       DepositStatus = "SUCCESS_L2_APPROVED"
+	DepositStatusCensorFailure    DepositStatus = "FAILED_CENSOR_FAILURE"
+	DepositStatusL2Reject         DepositStatus = "FAILED_L2_REJECT"
+	DepositStatusL2RejectApproved DepositStatus = "FAILED_L2_REJECT_APPROVED"
+	DepositStatusUnknown          DepositStatus = "UNKNOWN_DEPOSIT_STATUS"
+)
+
+// IsSuccess reports whether the deposit passed censoring and its funds are credited
+func (s DepositStatus) IsSuccess() bool {
+	return s == DepositStatusCensorSuccess || s == DepositStatusL2Approved
+}
+
+// IsFailed reports whether the deposit was rejected by censoring or on L2
+func (s DepositStatus) IsFailed() bool {
+	return s == DepositStatusCensorFailure || s == DepositStatusL2Reject || s == DepositStatusL2RejectApproved
+}
+
+// IsFinal reports whether the status can no longer change
+func (s DepositStatus) IsFinal() bool {
+	return s == DepositStatusL2Approved || s == DepositStatusCensorFailure || s == DepositStatusL2RejectApproved
+}
+
+// DefaultPollInterval is the default interval between deposit status checks
+const DefaultPollInterval = 5 * time.Second
+
+// ErrDepositFailed is returned by WaitForDeposit when the deposit was rejected
+var ErrDepositFailed = errors.New("deposit failed")
+
+// WaitForDepositParams represents the parameters for WaitForDeposit
+type WaitForDepositParams struct {
+	// PollInterval is the interval between status checks, 0 uses DefaultPollInterval
+	PollInterval time.Duration
+	// UntilCredited returns once the funds are credited instead of waiting for L2 approval
+	UntilCredited bool
+	// OnUpdate is called whenever the status of the deposit changes
+	OnUpdate func(deposit *openapi.Deposit)
+}
+
+// WaitForDeposit polls a deposit until it reaches a final status, or is credited
+// when UntilCredited is set. It returns the last state of the deposit, with an
+// error wrapping ErrDepositFailed if the deposit was rejected. Bound the wait
+// with the deadline of ctx.
+func (c *Client) WaitForDeposit(ctx context.Context, depositId string, params *WaitForDepositParams) (*openapi.Deposit, error) {
+	if params == nil {
+		params = &WaitForDepositParams{}
+	}
+	interval := params.PollInterval
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var lastStatus string
+	for {
+		resp, err := c.GetDepositById(ctx, []string{depositId})
+		if err != nil {
+			return nil, err
+		}
+		deposits := resp.GetData()
+		if len(deposits) == 0 {
+			return nil, fmt.Errorf("deposit %s not found", depositId)
+		}
+		deposit := &deposits[0]
+
+		if deposit.GetStatus() != lastStatus {
+			lastStatus = deposit.GetStatus()
+			if params.OnUpdate != nil {
+				params.OnUpdate(deposit)
+			}
+		}
+
+		status := DepositStatus(deposit.GetStatus())
+		if status.IsFailed() {
+			return deposit, fmt.Errorf("%w: %s", ErrDepositFailed, FailureReason(deposit))
+		}
+		if status.IsFinal() || (params.UntilCredited && status.IsSuccess()) {
+			return deposit, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return deposit, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// FailureReason describes why a deposit failed, or returns an empty string
+func FailureReason(deposit *openapi.Deposit) string {
+	switch DepositStatus(deposit.GetStatus()) {
+	case DepositStatusCensorFailure:
+		return fmt.Sprintf("censor failure %s: %s", deposit.GetCensorFailCode(), deposit.GetCensorFailReason())
+	case DepositStatusL2Reject, DepositStatusL2RejectApproved:
+		return fmt.Sprintf("l2 reject %s: %s", deposit.GetL2RejectCode(), deposit.GetL2RejectReason())
+	}
+	return ""
+}
+
+// DepositTimeline holds the times a deposit passed each stage, zero if not reached
+type DepositTimeline struct {
+	Created    time.Time
+	Censored   time.Time
+	L2Rejected time.Time
+	L2Approved time.Time
+	Updated    time.Time
+}
+
+// Timeline returns the stage times of a deposit from its millisecond timestamps
+func Timeline(deposit *openapi.Deposit) DepositTimeline {
+	return DepositTimeline{
+		Created:    internal.ParseMillis(deposit.GetCreatedTime()),
+		Censored:   internal.ParseMillis(deposit.GetCensorTime()),
+		L2Rejected: internal.ParseMillis(deposit.GetL2RejectTime()),
+		L2Approved: internal.ParseMillis(deposit.GetL2ApprovedTime()),
+		Updated:    internal.ParseMillis(deposit.GetUpdatedTime()),
+	}
+}
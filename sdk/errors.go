@@ -0,0 +1,38 @@
+package sdk
+
+import (
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/asset"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/deposit"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/internal"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/order"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/transfer"
+)
+
+// APIError represents a failed request to the edgeX API.
+// Use errors.As to retrieve it from errors returned by the SDK.
+type APIError = internal.APIError
+
+// Sentinel errors for classifying API failures with errors.Is
+var (
+	ErrInsufficientMargin = internal.ErrInsufficientMargin
+	ErrOrderNotFound      = internal.ErrOrderNotFound
+	ErrRateLimited        = internal.ErrRateLimited
+	ErrSignatureInvalid   = internal.ErrSignatureInvalid
+	ErrMaintenance        = internal.ErrMaintenance
+)
+
+// ErrOrderFilled is returned by ReplaceOrder when the order was filled before the cancel
+var ErrOrderFilled = order.ErrOrderFilled
+
+// ErrDepositFailed is returned by WaitForDeposit when the deposit was rejected
+var ErrDepositFailed = deposit.ErrDepositFailed
+
+// ErrWithdrawAmountExceeded is returned by FastWithdraw and CrossWithdraw when the
+// amount exceeds the maximum of the liquidity provider
+var ErrWithdrawAmountExceeded = asset.ErrWithdrawAmountExceeded
+
+// ErrWithdrawFailed is returned by the withdrawal trackers when the withdrawal was rejected
+var ErrWithdrawFailed = asset.ErrWithdrawFailed
+
+// ErrTransferFailed is returned by WaitForTransfer when the transfer was rejected
+var ErrTransferFailed = transfer.ErrTransferFailed
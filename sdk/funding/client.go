@@ -50,16 +50,13 @@ func (c *Client) GetFundingRate(ctx context.Context, params GetFundingRateParams
 		req = req.FilterEndTimeExclusive(fmt.Sprintf("%d", *params.To))
 	}
 
-	resp, _, err := req.Execute()
+	resp, httpResp, err := req.Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get funding rate: %w", err)
+		return nil, fmt.Errorf("failed to get funding rate: %w", internal.ParseError(err, httpResp))
 	}
 
-	if resp.GetCode() != "SUCCESS" {
-		if errorParam := resp.GetErrorParam(); errorParam != nil {
-			return nil, fmt.Errorf("request failed with error params: %v", errorParam)
-		}
-		return nil, fmt.Errorf("request failed with code: %s", resp.GetCode())
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
 	}
 
 	return resp, nil
@@ -75,16 +72,13 @@ func (c *Client) GetLatestFundingRate(ctx context.Context, params GetLatestFundi
 	req := c.openapiClient.Class01FundingPublicApiAPI.GetLatestFundingRate(ctx).
 		ContractId(params.ContractID)
 
-	resp, _, err := req.Execute()
+	resp, httpResp, err := req.Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get latest funding rate: %w", err)
+		return nil, fmt.Errorf("failed to get latest funding rate: %w", internal.ParseError(err, httpResp))
 	}
 
-	if resp.GetCode() != "SUCCESS" {
-		if errorParam := resp.GetErrorParam(); errorParam != nil {
-			return nil, fmt.Errorf("request failed with error params: %v", errorParam)
-		}
-		return nil, fmt.Errorf("request failed with code: %s", resp.GetCode())
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
 	}
 
 	return resp, nil
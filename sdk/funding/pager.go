@@ -0,0 +1,37 @@
+package funding
+
+import (
+	"context"
+	"time"
+
+	openapi "github.com/edgex-Tech/edgex-golang-sdk/openapi"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/pager"
+)
+
+// FundingRatesPager returns a pager over all settlement funding rates of a contract.
+// The Size and Offset of params are managed by the pager.
+func (c *Client) FundingRatesPager(params GetFundingRateParams, opts *pager.Options) *pager.Pager[openapi.FundingRate] {
+	return pager.New(func(ctx context.Context, offset string, size int) ([]openapi.FundingRate, string, error) {
+		pageSize := int32(size)
+		params.Size, params.Offset = &pageSize, nil
+		if offset != "" {
+			params.Offset = &offset
+		}
+		resp, err := c.GetFundingRate(ctx, params)
+		if err != nil {
+			return nil, "", err
+		}
+		data := resp.GetData()
+		return data.GetDataList(), data.GetNextPageOffsetData(), nil
+	}, opts)
+}
+
+// CollectFundingRates collects all settlement funding rates of a contract in the window,
+// fetching it in spans of w.Span. The From and To of params are replaced by each span.
+func (c *Client) CollectFundingRates(ctx context.Context, params GetFundingRateParams, w pager.Window, opts *pager.Options) ([]openapi.FundingRate, error) {
+	return pager.Collect(ctx, w, opts, func(start, end time.Time, opts *pager.Options) *pager.Pager[openapi.FundingRate] {
+		from, to := start.UnixMilli(), end.UnixMilli()
+		params.From, params.To = &from, &to
+		return c.FundingRatesPager(params, opts)
+	})
+}
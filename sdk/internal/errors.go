@@ -0,0 +1,156 @@
+package internal
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	openapi "github.com/edgex-Tech/edgex-golang-sdk/openapi"
+)
+
+// ResponseCodeSuccess is the code returned by the API for successful requests
+const ResponseCodeSuccess = "SUCCESS"
+
+// Sentinel errors used to classify API failures with errors.Is
+var (
+	ErrInsufficientMargin = errors.New("insufficient margin")
+	ErrOrderNotFound      = errors.New("order not found")
+	ErrRateLimited        = errors.New("rate limited")
+	ErrSignatureInvalid   = errors.New("signature invalid")
+	ErrMaintenance        = errors.New("exchange under maintenance")
+)
+
+// errorCodeKeywords maps code fragments returned by the API to sentinel errors
+var errorCodeKeywords = []struct {
+	keyword string
+	err     error
+}{
+	{"MARGIN_NOT_ENOUGH", ErrInsufficientMargin},
+	{"INSUFFICIENT_MARGIN", ErrInsufficientMargin},
+	{"BALANCE_NOT_ENOUGH", ErrInsufficientMargin},
+	{"ORDER_NOT_FOUND", ErrOrderNotFound},
+	{"ORDER_NOT_EXIST", ErrOrderNotFound},
+	{"RATE_LIMIT", ErrRateLimited},
+	{"TOO_MANY_REQUEST", ErrRateLimited},
+	{"SIGNATURE", ErrSignatureInvalid},
+	{"SIGN_INVALID", ErrSignatureInvalid},
+	{"MAINTENANCE", ErrMaintenance},
+}
+
+// Response is implemented by every Result model of the OpenAPI client
+type Response interface {
+	GetCode() string
+	GetErrorParam() map[string]string
+	GetTraceId() string
+	GetRequestTime() string
+	GetResponseTime() string
+}
+
+// APIError represents a failed request to the edgeX API
+type APIError struct {
+	Code         string            // Response code, empty if the server did not return one
+	Msg          string            // Error message, if any
+	ErrorParam   map[string]string // Structured error parameters
+	TraceId      string            // Trace ID of the request
+	RequestTime  string            // Server request receive time
+	ResponseTime string            // Server response time
+	HTTPStatus   int               // HTTP status code of the response
+}
+
+// Error implements the error interface
+func (e *APIError) Error() string {
+	var sb strings.Builder
+	if e.Code != "" {
+		fmt.Fprintf(&sb, "request failed with code: %s", e.Code)
+	} else {
+		fmt.Fprintf(&sb, "request failed with http status: %d", e.HTTPStatus)
+	}
+	if e.Msg != "" {
+		fmt.Fprintf(&sb, ", msg: %s", e.Msg)
+	}
+	if len(e.ErrorParam) > 0 {
+		fmt.Fprintf(&sb, ", error params: %v", e.ErrorParam)
+	}
+	if e.TraceId != "" {
+		fmt.Fprintf(&sb, ", traceId: %s", e.TraceId)
+	}
+	return sb.String()
+}
+
+// Is reports whether the error matches one of the sentinel classifications
+func (e *APIError) Is(target error) bool {
+	switch {
+	case target == ErrRateLimited && e.HTTPStatus == http.StatusTooManyRequests:
+		return true
+	case target == ErrMaintenance && e.HTTPStatus == http.StatusServiceUnavailable:
+		return true
+	}
+
+	code := strings.ToUpper(e.Code)
+	if code == "" {
+		return false
+	}
+	for _, k := range errorCodeKeywords {
+		if k.err == target && strings.Contains(code, k.keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckResponse returns an *APIError if the response code is not SUCCESS
+func CheckResponse(resp Response, httpResp *http.Response) error {
+	if resp.GetCode() == ResponseCodeSuccess {
+		return nil
+	}
+
+	apiErr := &APIError{
+		Code:         resp.GetCode(),
+		ErrorParam:   resp.GetErrorParam(),
+		TraceId:      resp.GetTraceId(),
+		RequestTime:  resp.GetRequestTime(),
+		ResponseTime: resp.GetResponseTime(),
+	}
+	if httpResp != nil {
+		apiErr.HTTPStatus = httpResp.StatusCode
+	}
+	return apiErr
+}
+
+// ParseError converts an error returned by the OpenAPI client into an *APIError
+// when the server answered with an error status. Other errors are returned as is.
+func ParseError(err error, httpResp *http.Response) error {
+	if err == nil || httpResp == nil || httpResp.StatusCode < 300 {
+		return err
+	}
+
+	apiErr := &APIError{
+		HTTPStatus: httpResp.StatusCode,
+		Msg:        err.Error(),
+	}
+
+	var openapiErr *openapi.GenericOpenAPIError
+	if errors.As(err, &openapiErr) {
+		var body struct {
+			Code         string            `json:"code"`
+			Msg          string            `json:"msg"`
+			ErrorParam   map[string]string `json:"errorParam"`
+			TraceId      string            `json:"traceId"`
+			RequestTime  string            `json:"requestTime"`
+			ResponseTime string            `json:"responseTime"`
+		}
+		if json.Unmarshal(openapiErr.Body(), &body) == nil {
+			apiErr.Code = body.Code
+			if body.Msg != "" {
+				apiErr.Msg = body.Msg
+			}
+			apiErr.ErrorParam = body.ErrorParam
+			apiErr.TraceId = body.TraceId
+			apiErr.RequestTime = body.RequestTime
+			apiErr.ResponseTime = body.ResponseTime
+		}
+	}
+	return apiErr
+}
@@ -0,0 +1,79 @@
+package internal
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+const (
+	// DefaultL2ExpireDuration is the default lifetime of a signed L2 message
+	DefaultL2ExpireDuration = 14 * 24 * time.Hour
+	// L2ExpireBuffer is the time the L2 signature must outlive the order expire time
+	L2ExpireBuffer = 10 * 24 * time.Hour
+)
+
+// Expiry holds the expire times of a signed L2 message in unix milliseconds
+type Expiry struct {
+	ExpireTime   int64 // Time the order or transfer expires on the exchange
+	L2ExpireTime int64 // Time the L2 signature expires
+}
+
+// L2ExpireHour returns the L2 expire time in unix hours, as used in the L2 hashes
+func (e Expiry) L2ExpireHour() int64 {
+	return e.L2ExpireTime / (60 * 60 * 1000)
+}
+
+// ResolveExpiry derives the expire times from the first of an explicit L2 expire time
+// in milliseconds, an absolute expire time or a duration from now. When none is given
+// the L2 signature expires after DefaultL2ExpireDuration. The L2 expire time is always
+// L2ExpireBuffer after the expire time.
+func ResolveExpiry(now time.Time, l2ExpireTime *int64, expireTime *time.Time, expireAfter time.Duration) (Expiry, error) {
+	var expiry Expiry
+	switch {
+	case l2ExpireTime != nil:
+		expiry.L2ExpireTime = *l2ExpireTime
+		expiry.ExpireTime = *l2ExpireTime - L2ExpireBuffer.Milliseconds()
+	case expireTime != nil:
+		expiry.ExpireTime = expireTime.UnixMilli()
+		expiry.L2ExpireTime = expiry.ExpireTime + L2ExpireBuffer.Milliseconds()
+	case expireAfter != 0:
+		expiry.ExpireTime = now.Add(expireAfter).UnixMilli()
+		expiry.L2ExpireTime = expiry.ExpireTime + L2ExpireBuffer.Milliseconds()
+	default:
+		expiry.L2ExpireTime = now.Add(DefaultL2ExpireDuration).UnixMilli()
+		expiry.ExpireTime = expiry.L2ExpireTime - L2ExpireBuffer.Milliseconds()
+	}
+
+	return expiry, validateExpiry(now, expiry)
+}
+
+// ResolveL2Expiry derives the expire time of an L2 message without an exchange side
+// expire time, such as a transfer, from an absolute time or a duration from now.
+// When neither is given the L2 signature expires after DefaultL2ExpireDuration.
+func ResolveL2Expiry(now time.Time, expireTime *time.Time, expireAfter time.Duration) (Expiry, error) {
+	expireAt := now.Add(DefaultL2ExpireDuration)
+	switch {
+	case expireTime != nil:
+		expireAt = *expireTime
+	case expireAfter != 0:
+		expireAt = now.Add(expireAfter)
+	}
+
+	expiry := Expiry{ExpireTime: expireAt.UnixMilli(), L2ExpireTime: expireAt.UnixMilli()}
+	return expiry, validateExpiry(now, expiry)
+}
+
+// validateExpiry rejects expire times that cannot be valid. Other limits are left
+// to the server, which rejects messages outside its accepted range.
+func validateExpiry(now time.Time, expiry Expiry) error {
+	if expiry.ExpireTime <= now.UnixMilli() {
+		return fmt.Errorf("expire time %s is not in the future",
+			time.UnixMilli(expiry.ExpireTime).UTC().Format(time.RFC3339))
+	}
+	// The expiration timestamp is a 32 bit field of the L2 hashes
+	if expiry.L2ExpireHour() > math.MaxUint32 {
+		return fmt.Errorf("l2 expire hour %d exceeds 32 bits", expiry.L2ExpireHour())
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testNow = time.Date(2024, 1, 1, 0, 30, 0, 0, time.UTC)
+
+const testDay = int64(24 * 60 * 60 * 1000)
+
+func TestResolveExpiry(t *testing.T) {
+	l2ExpireTime := testNow.Add(20 * 24 * time.Hour).UnixMilli()
+	expireTime := testNow.Add(5 * 24 * time.Hour)
+
+	tests := []struct {
+		name         string
+		l2ExpireTime *int64
+		expireTime   *time.Time
+		expireAfter  time.Duration
+		expected     Expiry
+	}{
+		{
+			name:     "default",
+			expected: Expiry{ExpireTime: testNow.UnixMilli() + 4*testDay, L2ExpireTime: testNow.UnixMilli() + 14*testDay},
+		},
+		{
+			name:        "expire after",
+			expireAfter: time.Hour,
+			expected:    Expiry{ExpireTime: testNow.Add(time.Hour).UnixMilli(), L2ExpireTime: testNow.Add(time.Hour).UnixMilli() + 10*testDay},
+		},
+		{
+			name:        "expire time over expire after",
+			expireTime:  &expireTime,
+			expireAfter: time.Hour,
+			expected:    Expiry{ExpireTime: expireTime.UnixMilli(), L2ExpireTime: expireTime.UnixMilli() + 10*testDay},
+		},
+		{
+			name:         "l2 expire time over expire time",
+			l2ExpireTime: &l2ExpireTime,
+			expireTime:   &expireTime,
+			expireAfter:  time.Hour,
+			expected:     Expiry{ExpireTime: l2ExpireTime - 10*testDay, L2ExpireTime: l2ExpireTime},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expiry, err := ResolveExpiry(testNow, tt.l2ExpireTime, tt.expireTime, tt.expireAfter)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, expiry)
+		})
+	}
+}
+
+func TestResolveExpiryRejectsPastTimes(t *testing.T) {
+	past := testNow.Add(-time.Minute)
+	_, err := ResolveExpiry(testNow, nil, &past, 0)
+	assert.Error(t, err)
+
+	_, err = ResolveExpiry(testNow, nil, &testNow, 0)
+	assert.Error(t, err)
+
+	_, err = ResolveExpiry(testNow, nil, nil, -time.Hour)
+	assert.Error(t, err)
+
+	// The derived expire time is in the past although the L2 expire time is not
+	l2ExpireTime := testNow.Add(5 * 24 * time.Hour).UnixMilli()
+	_, err = ResolveExpiry(testNow, &l2ExpireTime, nil, 0)
+	assert.Error(t, err)
+}
+
+func TestResolveL2Expiry(t *testing.T) {
+	expiry, err := ResolveL2Expiry(testNow, nil, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, Expiry{ExpireTime: testNow.UnixMilli() + 14*testDay, L2ExpireTime: testNow.UnixMilli() + 14*testDay}, expiry)
+
+	expireTime := testNow.Add(2 * time.Hour)
+	expiry, err = ResolveL2Expiry(testNow, &expireTime, time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, expireTime.UnixMilli(), expiry.L2ExpireTime)
+
+	expiry, err = ResolveL2Expiry(testNow, nil, time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, testNow.Add(time.Hour).UnixMilli(), expiry.L2ExpireTime)
+
+	past := testNow.Add(-time.Hour)
+	_, err = ResolveL2Expiry(testNow, &past, 0)
+	assert.Error(t, err)
+}
+
+func TestL2ExpireHour(t *testing.T) {
+	// Partial hours are truncated
+	expiry := Expiry{L2ExpireTime: testNow.UnixMilli()}
+	assert.Equal(t, testNow.Truncate(time.Hour).Unix()/3600, expiry.L2ExpireHour())
+
+	expiry = Expiry{L2ExpireTime: testNow.Add(29*time.Minute + 59*time.Second).UnixMilli()}
+	assert.Equal(t, testNow.Truncate(time.Hour).Unix()/3600, expiry.L2ExpireHour())
+
+	expiry = Expiry{L2ExpireTime: testNow.Add(30 * time.Minute).UnixMilli()}
+	assert.Equal(t, testNow.Truncate(time.Hour).Unix()/3600+1, expiry.L2ExpireHour())
+}
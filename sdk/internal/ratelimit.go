@@ -0,0 +1,123 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// TokenBucket is a token bucket rate limiter safe for concurrent use.
+// A bucket with a non-positive rate never limits.
+type TokenBucket struct {
+	mu          sync.Mutex
+	rate        float64       // Tokens added per second
+	burst       float64       // Maximum number of tokens
+	minInterval time.Duration // Minimum spacing between two takes
+	tokens      float64
+	last        time.Time
+	next        time.Time // Earliest time the next take is allowed by minInterval
+}
+
+// NewTokenBucket creates a token bucket starting full
+func NewTokenBucket(rate float64, burst int, minInterval time.Duration) *TokenBucket {
+	b := &TokenBucket{}
+	b.SetLimit(rate, burst, minInterval)
+	return b
+}
+
+// SetLimit updates the bucket limits, keeping the tokens already available
+func (b *TokenBucket) SetLimit(rate float64, burst int, minInterval time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if burst < 1 {
+		burst = 1
+	}
+	b.advance(time.Now())
+	b.rate = rate
+	b.burst = float64(burst)
+	b.minInterval = minInterval
+	if b.last.IsZero() || b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+	b.last = time.Now()
+}
+
+// advance refills the bucket up to now, must be called with the lock held
+func (b *TokenBucket) advance(now time.Time) {
+	if b.last.IsZero() || b.rate <= 0 {
+		return
+	}
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens += elapsed * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+	}
+	b.last = now
+}
+
+// Allow takes a token if one is available right now
+func (b *TokenBucket) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.rate <= 0 && b.minInterval <= 0 {
+		return true
+	}
+	now := time.Now()
+	b.advance(now)
+	if now.Before(b.next) || (b.rate > 0 && b.tokens < 1) {
+		return false
+	}
+	if b.rate > 0 {
+		b.tokens--
+	}
+	b.next = now.Add(b.minInterval)
+	return true
+}
+
+// Wait blocks until a token is available or the context is done
+func (b *TokenBucket) Wait(ctx context.Context) error {
+	b.mu.Lock()
+	if b.rate <= 0 && b.minInterval <= 0 {
+		b.mu.Unlock()
+		return nil
+	}
+
+	// Reserve a token, the balance may go negative while waiting
+	now := time.Now()
+	b.advance(now)
+	var delay time.Duration
+	if b.rate > 0 {
+		b.tokens--
+		if b.tokens < 0 {
+			delay = time.Duration(-b.tokens / b.rate * float64(time.Second))
+		}
+	}
+	if wait := b.next.Sub(now); wait > delay {
+		delay = wait
+	}
+	b.next = now.Add(delay + b.minInterval)
+	b.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		// Give the reserved token back
+		b.mu.Lock()
+		if b.rate > 0 {
+			b.tokens++
+		}
+		b.mu.Unlock()
+		return fmt.Errorf("rate limiter wait aborted: %w", ctx.Err())
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,16 @@
+package internal
+
+import "context"
+
+type idempotentKey struct{}
+
+// WithIdempotent marks requests issued with the returned context as safe to retry
+func WithIdempotent(ctx context.Context) context.Context {
+	return context.WithValue(ctx, idempotentKey{}, true)
+}
+
+// IsIdempotent reports whether the context was marked with WithIdempotent
+func IsIdempotent(ctx context.Context) bool {
+	idempotent, _ := ctx.Value(idempotentKey{}).(bool)
+	return idempotent
+}
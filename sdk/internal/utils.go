@@ -5,8 +5,11 @@ import (
 	"fmt"
 	"math/big"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
 
+	openapi "github.com/edgex-Tech/edgex-golang-sdk/openapi"
 	"github.com/edgex-Tech/edgex-golang-sdk/starkcurve"
 
 	"github.com/google/uuid"
@@ -100,6 +103,17 @@ func CalcLimitOrderHash(assetIdSynthetic, assetIdCollateral, assetIdFee string,
 
 // CalcTransferHash calculates the hash for a transfer
 func CalcTransferHash(assetID, assetIdFee, receiverPublicKey *big.Int, senderPositionId, receiverPositionId, feePositionId, nonce, amount, maxAmountFee, expirationTimestamp int64) []byte {
+	return calcTransferHash(TransferType, assetID, assetIdFee, receiverPublicKey, nil, senderPositionId, receiverPositionId, feePositionId, nonce, amount, maxAmountFee, expirationTimestamp)
+}
+
+// CalcConditionalTransferHash calculates the hash for a transfer that only settles
+// once the condition, e.g. an L1 fact, is fulfilled
+func CalcConditionalTransferHash(assetID, assetIdFee, receiverPublicKey, condition *big.Int, senderPositionId, receiverPositionId, feePositionId, nonce, amount, maxAmountFee, expirationTimestamp int64) []byte {
+	return calcTransferHash(CondTransferType, assetID, assetIdFee, receiverPublicKey, condition, senderPositionId, receiverPositionId, feePositionId, nonce, amount, maxAmountFee, expirationTimestamp)
+}
+
+// calcTransferHash calculates the hash for a transfer, the condition is nil for plain transfers
+func calcTransferHash(transferType int64, assetID, assetIdFee, receiverPublicKey, condition *big.Int, senderPositionId, receiverPositionId, feePositionId, nonce, amount, maxAmountFee, expirationTimestamp int64) []byte {
 	assetIDInt := big.NewInt(0).Set(assetID)
 	assetIdFeeInt := big.NewInt(0).Set(assetIdFee)
 	msg := starkcurve.CalcHash([]*big.Int{assetIDInt, assetIdFeeInt})
@@ -108,6 +122,12 @@ func CalcTransferHash(assetID, assetIdFee, receiverPublicKey *big.Int, senderPos
 	msgInt := big.NewInt(0).SetBytes(msg)
 	msg = starkcurve.CalcHash([]*big.Int{msgInt, receiverPublicKeyInt})
 
+	if condition != nil {
+		conditionInt := big.NewInt(0).Set(condition)
+		msgInt = big.NewInt(0).SetBytes(msg)
+		msg = starkcurve.CalcHash([]*big.Int{msgInt, conditionInt})
+	}
+
 	packedMsg0 := big.NewInt(senderPositionId)
 	packedMsg0 = packedMsg0.Lsh(packedMsg0, 64)
 	receiverPositionIdInt := big.NewInt(receiverPositionId)
@@ -121,7 +141,7 @@ func CalcTransferHash(assetID, assetIdFee, receiverPublicKey *big.Int, senderPos
 	msgInt = big.NewInt(0).SetBytes(msg)
 	msg = starkcurve.CalcHash([]*big.Int{msgInt, packedMsg0})
 
-	packedMsg1 := big.NewInt(4)
+	packedMsg1 := big.NewInt(transferType)
 	packedMsg1 = packedMsg1.Lsh(packedMsg1, 64)
 	amountInt := big.NewInt(amount)
 	packedMsg1 = packedMsg1.Add(packedMsg1, amountInt)
@@ -138,11 +158,97 @@ func CalcTransferHash(assetID, assetIdFee, receiverPublicKey *big.Int, senderPos
 	return msg
 }
 
+// CalcWithdrawalHash calculates the hash for a withdrawal to the L1 address registered
+// with the stark key of the position
+func CalcWithdrawalHash(assetIdCollateral *big.Int, positionId, nonce, amount, expirationTimestamp int64) []byte {
+	assetIdCollateralInt := big.NewInt(0).Set(assetIdCollateral)
+
+	packedMsg := big.NewInt(WithdrawalOrderType)
+	packedMsg = packedMsg.Lsh(packedMsg, 64)
+	packedMsg = packedMsg.Add(packedMsg, big.NewInt(positionId))
+	packedMsg = packedMsg.Lsh(packedMsg, 32)
+	packedMsg = packedMsg.Add(packedMsg, big.NewInt(nonce))
+	packedMsg = packedMsg.Lsh(packedMsg, 64)
+	packedMsg = packedMsg.Add(packedMsg, big.NewInt(amount))
+	packedMsg = packedMsg.Lsh(packedMsg, 32)
+	packedMsg = packedMsg.Add(packedMsg, big.NewInt(expirationTimestamp))
+	packedMsg = packedMsg.Lsh(packedMsg, 49)
+	msg := starkcurve.CalcHash([]*big.Int{assetIdCollateralInt, packedMsg})
+
+	return msg
+}
+
+// CalcWithdrawalToAddressHash calculates the hash for a withdrawal to an L1 address
+func CalcWithdrawalToAddressHash(assetIdCollateral, ethAddress *big.Int, positionId, nonce, amount, expirationTimestamp int64) []byte {
+	assetIdCollateralInt := big.NewInt(0).Set(assetIdCollateral)
+	ethAddressInt := big.NewInt(0).Set(ethAddress)
+	msg := starkcurve.CalcHash([]*big.Int{assetIdCollateralInt, ethAddressInt})
+
+	packedMsg := big.NewInt(WithdrawalToAddress)
+	packedMsg = packedMsg.Lsh(packedMsg, 64)
+	packedMsg = packedMsg.Add(packedMsg, big.NewInt(positionId))
+	packedMsg = packedMsg.Lsh(packedMsg, 32)
+	packedMsg = packedMsg.Add(packedMsg, big.NewInt(nonce))
+	packedMsg = packedMsg.Lsh(packedMsg, 64)
+	packedMsg = packedMsg.Add(packedMsg, big.NewInt(amount))
+	packedMsg = packedMsg.Lsh(packedMsg, 32)
+	packedMsg = packedMsg.Add(packedMsg, big.NewInt(expirationTimestamp))
+	packedMsg = packedMsg.Lsh(packedMsg, 49)
+	msgInt := big.NewInt(0).SetBytes(msg)
+	msg = starkcurve.CalcHash([]*big.Int{msgInt, packedMsg})
+
+	return msg
+}
+
+// CoinAssetID returns the StarkEx asset ID of a coin, which must be listed in the metadata
+func CoinAssetID(metadata openapi.MetaData, coinId string) (*big.Int, error) {
+	assetIDStr := ""
+	for _, coin := range metadata.GetCoinList() {
+		if coin.GetCoinId() == coinId {
+			assetIDStr = coin.GetStarkExAssetId()
+			break
+		}
+	}
+	if assetIDStr == "" {
+		global := metadata.GetGlobal()
+		collateralCoin := global.GetStarkExCollateralCoin()
+		if collateralCoin.GetCoinId() != coinId {
+			return nil, fmt.Errorf("coin not found: %s", coinId)
+		}
+		assetIDStr = collateralCoin.GetStarkExAssetId()
+	}
+
+	assetID, ok := new(big.Int).SetString(assetIDStr, 0)
+	if !ok {
+		return nil, fmt.Errorf("invalid asset ID format: %s", assetIDStr)
+	}
+	return assetID, nil
+}
+
 // JoinStrings joins a slice of strings with commas
 func JoinStrings(strs []string) string {
 	return strings.Join(strs, ",")
 }
 
+// ParseMillis parses a millisecond timestamp, empty or zero is the zero time
+func ParseMillis(value string) time.Time {
+	ms, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || ms <= 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(ms)
+}
+
+// FirstNonEmpty returns the first non-empty value
+func FirstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
 // GetValue converts a JSON value to a string representation
 func GetValue(value interface{}) string {
 	if value == nil {
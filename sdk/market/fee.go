@@ -0,0 +1,80 @@
+package market
+
+import (
+	"fmt"
+
+	openapi "github.com/edgex-Tech/edgex-golang-sdk/openapi"
+	"github.com/shopspring/decimal"
+)
+
+// FeeRates holds the fee rates an account pays in a market
+type FeeRates struct {
+	ContractId   string
+	TakerFeeRate decimal.Decimal
+	MakerFeeRate decimal.Decimal
+}
+
+// ResolveFeeRates resolves the fee rates of an account in a market. Trade settings are
+// applied in priority order: the account's contract setting, the account's default
+// setting, then the market defaults. A setting either replaces the rates or applies a
+// discount to the market defaults. A nil account resolves to the market defaults.
+func ResolveFeeRates(m *Market, account *openapi.Account) (*FeeRates, error) {
+	rates := &FeeRates{
+		ContractId:   m.ContractId,
+		TakerFeeRate: m.DefaultTakerFeeRate,
+		MakerFeeRate: m.DefaultMakerFeeRate,
+	}
+	if account == nil {
+		return rates, nil
+	}
+
+	var settings []openapi.TradeSetting
+	if setting, ok := account.GetContractIdToTradeSetting()[m.ContractId]; ok {
+		settings = append(settings, setting)
+	}
+	if account.HasDefaultTradeSetting() {
+		settings = append(settings, account.GetDefaultTradeSetting())
+	}
+
+	for _, setting := range settings {
+		switch {
+		case setting.GetIsSetFeeRate():
+			taker, err := parseRate("takerFeeRate", setting.GetTakerFeeRate())
+			if err != nil {
+				return nil, err
+			}
+			maker, err := parseRate("makerFeeRate", setting.GetMakerFeeRate())
+			if err != nil {
+				return nil, err
+			}
+			rates.TakerFeeRate, rates.MakerFeeRate = taker, maker
+			return rates, nil
+		case setting.GetIsSetFeeDiscount():
+			takerDiscount, err := parseRate("takerFeeDiscount", setting.GetTakerFeeDiscount())
+			if err != nil {
+				return nil, err
+			}
+			makerDiscount, err := parseRate("makerFeeDiscount", setting.GetMakerFeeDiscount())
+			if err != nil {
+				return nil, err
+			}
+			one := decimal.NewFromInt(1)
+			rates.TakerFeeRate = m.DefaultTakerFeeRate.Mul(one.Sub(takerDiscount))
+			rates.MakerFeeRate = m.DefaultMakerFeeRate.Mul(one.Sub(makerDiscount))
+			return rates, nil
+		}
+	}
+	return rates, nil
+}
+
+// parseRate parses a rate of a trade setting, an empty value is zero
+func parseRate(name, value string) (decimal.Decimal, error) {
+	if value == "" {
+		return decimal.Zero, nil
+	}
+	d, err := decimal.NewFromString(value)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("invalid %s in trade setting: %s", name, value)
+	}
+	return d, nil
+}
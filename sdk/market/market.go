@@ -0,0 +1,188 @@
+package market
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	openapi "github.com/edgex-Tech/edgex-golang-sdk/openapi"
+	"github.com/shopspring/decimal"
+)
+
+// sideBuy is the order side for buy orders
+const sideBuy = "BUY"
+
+// Market represents a tradable contract with typed trading rules
+type Market struct {
+	ContractId   string
+	ContractName string
+	BaseCoinId   string
+	QuoteCoinId  string
+
+	TickSize               decimal.Decimal // Minimum price increment
+	StepSize               decimal.Decimal // Minimum size increment
+	MinOrderSize           decimal.Decimal // Minimum order size
+	MaxOrderSize           decimal.Decimal // Maximum order size
+	MaxOrderBuyPriceRatio  decimal.Decimal // Maximum buy price ratio relative to the oracle price
+	MinOrderSellPriceRatio decimal.Decimal // Minimum sell price ratio relative to the oracle price
+	MaxPositionSize        decimal.Decimal // Maximum position size
+	DefaultTakerFeeRate    decimal.Decimal // Default taker fee rate
+	DefaultMakerFeeRate    decimal.Decimal // Default maker fee rate
+
+	EnableTrade        bool // Whether trading is enabled, true if not set in the metadata
+	EnableOpenPosition bool // Whether opening positions is enabled, true if not set in the metadata
+
+	SyntheticAssetId string // StarkEx synthetic asset ID
+	Resolution       int64  // StarkEx resolution of the synthetic asset
+}
+
+// ValidationError describes an order field violating the market rules
+type ValidationError struct {
+	Field  string // Name of the violated field, e.g. "Size" or "Price"
+	Value  string // Rejected value
+	Reason string // Human readable reason
+}
+
+// Error implements the error interface
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid order %s %s: %s", e.Field, e.Value, e.Reason)
+}
+
+// NewMarket creates a Market from a contract in the exchange metadata
+func NewMarket(contract openapi.Contract) (*Market, error) {
+	m := &Market{
+		ContractId:         contract.GetContractId(),
+		ContractName:       contract.GetContractName(),
+		BaseCoinId:         contract.GetBaseCoinId(),
+		QuoteCoinId:        contract.GetQuoteCoinId(),
+		EnableTrade:        !contract.HasEnableTrade() || contract.GetEnableTrade(),
+		EnableOpenPosition: !contract.HasEnableOpenPosition() || contract.GetEnableOpenPosition(),
+		SyntheticAssetId:   contract.GetStarkExSyntheticAssetId(),
+	}
+
+	fields := []struct {
+		name  string
+		value string
+		dst   *decimal.Decimal
+	}{
+		{"tickSize", contract.GetTickSize(), &m.TickSize},
+		{"stepSize", contract.GetStepSize(), &m.StepSize},
+		{"minOrderSize", contract.GetMinOrderSize(), &m.MinOrderSize},
+		{"maxOrderSize", contract.GetMaxOrderSize(), &m.MaxOrderSize},
+		{"maxOrderBuyPriceRatio", contract.GetMaxOrderBuyPriceRatio(), &m.MaxOrderBuyPriceRatio},
+		{"minOrderSellPriceRatio", contract.GetMinOrderSellPriceRatio(), &m.MinOrderSellPriceRatio},
+		{"maxPositionSize", contract.GetMaxPositionSize(), &m.MaxPositionSize},
+		{"defaultTakerFeeRate", contract.GetDefaultTakerFeeRate(), &m.DefaultTakerFeeRate},
+		{"defaultMakerFeeRate", contract.GetDefaultMakerFeeRate(), &m.DefaultMakerFeeRate},
+	}
+	for _, f := range fields {
+		if f.value == "" {
+			continue
+		}
+		d, err := decimal.NewFromString(f.value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s for contract %s: %s", f.name, m.ContractId, f.value)
+		}
+		*f.dst = d
+	}
+
+	// Convert hex resolution to decimal
+	if hexResolution := contract.GetStarkExResolution(); hexResolution != "" {
+		resolution, err := strconv.ParseInt(strings.TrimPrefix(hexResolution, "0x"), 16, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse hex resolution: %w", err)
+		}
+		m.Resolution = resolution
+	}
+
+	return m, nil
+}
+
+// RoundPrice rounds the price to the tick size. Buy prices are rounded down and
+// sell prices are rounded up, so the rounded price is never more aggressive.
+func (m *Market) RoundPrice(price decimal.Decimal, side string) decimal.Decimal {
+	if !m.TickSize.IsPositive() {
+		return price
+	}
+	ticks := price.Div(m.TickSize)
+	if side == sideBuy {
+		ticks = ticks.Floor()
+	} else {
+		ticks = ticks.Ceil()
+	}
+	return ticks.Mul(m.TickSize)
+}
+
+// RoundSize rounds the size down to the step size
+func (m *Market) RoundSize(size decimal.Decimal) decimal.Decimal {
+	if !m.StepSize.IsPositive() {
+		return size
+	}
+	return size.Div(m.StepSize).Floor().Mul(m.StepSize)
+}
+
+// ValidateOrder checks the price and size against the market tick size, step size
+// and order size limits. It returns a *ValidationError naming the violated field.
+func (m *Market) ValidateOrder(price, size decimal.Decimal) error {
+	if !size.IsPositive() {
+		return &ValidationError{Field: "Size", Value: size.String(), Reason: "must be positive"}
+	}
+	if m.MinOrderSize.IsPositive() && size.LessThan(m.MinOrderSize) {
+		return &ValidationError{Field: "Size", Value: size.String(), Reason: fmt.Sprintf("below min order size %s", m.MinOrderSize)}
+	}
+	if m.MaxOrderSize.IsPositive() && size.GreaterThan(m.MaxOrderSize) {
+		return &ValidationError{Field: "Size", Value: size.String(), Reason: fmt.Sprintf("above max order size %s", m.MaxOrderSize)}
+	}
+	if m.StepSize.IsPositive() && !size.Mod(m.StepSize).IsZero() {
+		return &ValidationError{Field: "Size", Value: size.String(), Reason: fmt.Sprintf("not a multiple of step size %s", m.StepSize)}
+	}
+
+	if !price.IsPositive() {
+		return &ValidationError{Field: "Price", Value: price.String(), Reason: "must be positive"}
+	}
+	if m.TickSize.IsPositive() && !price.Mod(m.TickSize).IsZero() {
+		return &ValidationError{Field: "Price", Value: price.String(), Reason: fmt.Sprintf("not a multiple of tick size %s", m.TickSize)}
+	}
+	return nil
+}
+
+// ToSyntheticAmount converts an order size to the StarkEx synthetic amount
+func (m *Market) ToSyntheticAmount(size decimal.Decimal) int64 {
+	return size.Mul(decimal.NewFromInt(m.Resolution)).IntPart()
+}
+
+// FromSyntheticAmount converts a StarkEx synthetic amount to an order size
+func (m *Market) FromSyntheticAmount(amount int64) decimal.Decimal {
+	if m.Resolution == 0 {
+		return decimal.Zero
+	}
+	return decimal.NewFromInt(amount).Div(decimal.NewFromInt(m.Resolution))
+}
+
+// ValidatePriceBand checks the price against the MaxOrderBuyPriceRatio and
+// MinOrderSellPriceRatio bands, both relative to the oracle price
+func (m *Market) ValidatePriceBand(price, oraclePrice decimal.Decimal, side string) error {
+	if !oraclePrice.IsPositive() {
+		return nil
+	}
+	one := decimal.NewFromInt(1)
+	if side == sideBuy {
+		if !m.MaxOrderBuyPriceRatio.IsPositive() {
+			return nil
+		}
+		maxPrice := oraclePrice.Mul(one.Add(m.MaxOrderBuyPriceRatio))
+		if price.GreaterThan(maxPrice) {
+			return &ValidationError{Field: "Price", Value: price.String(), Reason: fmt.Sprintf("above max buy price %s for oracle price %s", maxPrice, oraclePrice)}
+		}
+		return nil
+	}
+
+	if !m.MinOrderSellPriceRatio.IsPositive() {
+		return nil
+	}
+	minPrice := oraclePrice.Mul(one.Sub(m.MinOrderSellPriceRatio))
+	if price.LessThan(minPrice) {
+		return &ValidationError{Field: "Price", Value: price.String(), Reason: fmt.Sprintf("below min sell price %s for oracle price %s", minPrice, oraclePrice)}
+	}
+	return nil
+}
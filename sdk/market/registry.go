@@ -0,0 +1,49 @@
+package market
+
+import (
+	openapi "github.com/edgex-Tech/edgex-golang-sdk/openapi"
+)
+
+// Registry indexes the markets of the exchange by contract ID and name
+type Registry struct {
+	markets []*Market
+	byID    map[string]*Market
+	byName  map[string]*Market
+}
+
+// NewRegistry creates a registry from the exchange metadata
+func NewRegistry(metadata openapi.MetaData) (*Registry, error) {
+	contractList := metadata.GetContractList()
+	r := &Registry{
+		markets: make([]*Market, 0, len(contractList)),
+		byID:    make(map[string]*Market, len(contractList)),
+		byName:  make(map[string]*Market, len(contractList)),
+	}
+	for _, contract := range contractList {
+		m, err := NewMarket(contract)
+		if err != nil {
+			return nil, err
+		}
+		r.markets = append(r.markets, m)
+		r.byID[m.ContractId] = m
+		r.byName[m.ContractName] = m
+	}
+	return r, nil
+}
+
+// Get returns the market with the given contract ID
+func (r *Registry) Get(contractID string) (*Market, bool) {
+	m, ok := r.byID[contractID]
+	return m, ok
+}
+
+// GetByName returns the market with the given contract name, e.g. "BTCUSDT"
+func (r *Registry) GetByName(contractName string) (*Market, bool) {
+	m, ok := r.byName[contractName]
+	return m, ok
+}
+
+// Markets returns all markets in metadata order
+func (r *Registry) Markets() []*Market {
+	return r.markets
+}
@@ -0,0 +1,236 @@
+package metadata
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	openapi "github.com/edgex-Tech/edgex-golang-sdk/openapi"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/market"
+)
+
+// DefaultCacheTTL is the default time-to-live of cached metadata
+const DefaultCacheTTL = 5 * time.Minute
+
+// Cache caches the exchange metadata and indexes contracts and coins.
+// Values returned by the cache are shared and must not be modified.
+type Cache struct {
+	client *Client
+	ttl    time.Duration
+
+	mu              sync.RWMutex
+	data            *openapi.MetaData
+	fetchedAt       time.Time
+	contractsByID   map[string]*openapi.Contract
+	contractsByName map[string]*openapi.Contract
+	coinsByID       map[string]*openapi.Coin
+	markets         *market.Registry
+
+	refreshMu sync.Mutex
+	stop      chan struct{}
+	stopOnce  sync.Once
+}
+
+// NewCache creates a new metadata cache. A non-positive ttl uses DefaultCacheTTL.
+func NewCache(client *Client, ttl time.Duration) *Cache {
+	if ttl <= 0 {
+		ttl = DefaultCacheTTL
+	}
+	return &Cache{
+		client: client,
+		ttl:    ttl,
+		stop:   make(chan struct{}),
+	}
+}
+
+// Get returns the cached metadata, fetching it if missing or expired
+func (c *Cache) Get(ctx context.Context) (*openapi.MetaData, error) {
+	if data := c.fresh(); data != nil {
+		return data, nil
+	}
+
+	c.refreshMu.Lock()
+	defer c.refreshMu.Unlock()
+
+	// Another caller may have refreshed while we were waiting
+	if data := c.fresh(); data != nil {
+		return data, nil
+	}
+	return c.refresh(ctx)
+}
+
+// Refresh fetches the metadata regardless of the cache state
+func (c *Cache) Refresh(ctx context.Context) error {
+	c.refreshMu.Lock()
+	defer c.refreshMu.Unlock()
+
+	_, err := c.refresh(ctx)
+	return err
+}
+
+// Invalidate drops the cached metadata so the next lookup fetches it again
+func (c *Cache) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.fetchedAt = time.Time{}
+}
+
+// StartAutoRefresh refreshes the metadata in the background at the given interval
+// until Stop is called. Failed refreshes keep the previously cached metadata.
+func (c *Cache) StartAutoRefresh(interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-c.stop:
+				return
+			case <-ticker.C:
+				ctx, cancel := context.WithTimeout(context.Background(), interval)
+				_ = c.Refresh(ctx)
+				cancel()
+			}
+		}
+	}()
+}
+
+// Stop stops the background refresh
+func (c *Cache) Stop() {
+	c.stopOnce.Do(func() {
+		close(c.stop)
+	})
+}
+
+// GetContract returns the contract with the given ID
+func (c *Cache) GetContract(ctx context.Context, contractID string) (*openapi.Contract, error) {
+	if _, err := c.Get(ctx); err != nil {
+		return nil, err
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	contract, ok := c.contractsByID[contractID]
+	if !ok {
+		return nil, fmt.Errorf("contract not found: %s", contractID)
+	}
+	return contract, nil
+}
+
+// GetContractByName returns the contract with the given name, e.g. "BTCUSDT"
+func (c *Cache) GetContractByName(ctx context.Context, contractName string) (*openapi.Contract, error) {
+	if _, err := c.Get(ctx); err != nil {
+		return nil, err
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	contract, ok := c.contractsByName[contractName]
+	if !ok {
+		return nil, fmt.Errorf("contract not found: %s", contractName)
+	}
+	return contract, nil
+}
+
+// GetMarket returns the typed market for the given contract ID
+func (c *Cache) GetMarket(ctx context.Context, contractID string) (*market.Market, error) {
+	if _, err := c.Get(ctx); err != nil {
+		return nil, err
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	m, ok := c.markets.Get(contractID)
+	if !ok {
+		return nil, fmt.Errorf("contract not found: %s", contractID)
+	}
+	return m, nil
+}
+
+// GetMarkets returns the market registry
+func (c *Cache) GetMarkets(ctx context.Context) (*market.Registry, error) {
+	if _, err := c.Get(ctx); err != nil {
+		return nil, err
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.markets, nil
+}
+
+// GetCoin returns the coin with the given ID
+func (c *Cache) GetCoin(ctx context.Context, coinID string) (*openapi.Coin, error) {
+	if _, err := c.Get(ctx); err != nil {
+		return nil, err
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	coin, ok := c.coinsByID[coinID]
+	if !ok {
+		return nil, fmt.Errorf("coin not found: %s", coinID)
+	}
+	return coin, nil
+}
+
+// GetCollateralCoin returns the StarkEx collateral coin
+func (c *Cache) GetCollateralCoin(ctx context.Context) (*openapi.Coin, error) {
+	data, err := c.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	global := data.GetGlobal()
+	if !global.HasStarkExCollateralCoin() {
+		return nil, fmt.Errorf("collateral coin not found in metadata")
+	}
+	return global.StarkExCollateralCoin, nil
+}
+
+// fresh returns the cached metadata if it has not expired
+func (c *Cache) fresh() *openapi.MetaData {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.data == nil || time.Since(c.fetchedAt) >= c.ttl {
+		return nil
+	}
+	return c.data
+}
+
+// refresh fetches and indexes the metadata, must be called with refreshMu held
+func (c *Cache) refresh(ctx context.Context) (*openapi.MetaData, error) {
+	resp, err := c.client.GetMetaData(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data := resp.GetData()
+	markets, err := market.NewRegistry(data)
+	if err != nil {
+		return nil, err
+	}
+
+	contractsByID := make(map[string]*openapi.Contract, len(data.ContractList))
+	contractsByName := make(map[string]*openapi.Contract, len(data.ContractList))
+	for i := range data.ContractList {
+		contract := &data.ContractList[i]
+		contractsByID[contract.GetContractId()] = contract
+		contractsByName[contract.GetContractName()] = contract
+	}
+	coinsByID := make(map[string]*openapi.Coin, len(data.CoinList))
+	for i := range data.CoinList {
+		coin := &data.CoinList[i]
+		coinsByID[coin.GetCoinId()] = coin
+	}
+
+	c.mu.Lock()
+	c.data = &data
+	c.fetchedAt = time.Now()
+	c.contractsByID = contractsByID
+	c.contractsByName = contractsByName
+	c.coinsByID = coinsByID
+	c.markets = markets
+	c.mu.Unlock()
+
+	return &data, nil
+}
@@ -25,16 +25,13 @@ func NewClient(client *internal.Client, openapiClient *openapi.APIClient) *Clien
 
 // GetServerTime gets the current server time
 func (c *Client) GetServerTime(ctx context.Context) (*openapi.ResultGetServerTime, error) {
-	resp, _, err := c.openapiClient.Class00MetaDataPublicApiAPI.GetServerTime(ctx).Execute()
+	resp, httpResp, err := c.openapiClient.Class00MetaDataPublicApiAPI.GetServerTime(ctx).Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get server time: %w", err)
+		return nil, fmt.Errorf("failed to get server time: %w", internal.ParseError(err, httpResp))
 	}
 
-	if resp.GetCode() != "SUCCESS" {
-		if errorParam := resp.GetErrorParam(); errorParam != nil {
-			return nil, fmt.Errorf("request failed with error params: %v", errorParam)
-		}
-		return nil, fmt.Errorf("request failed with code: %s", resp.GetCode())
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
 	}
 
 	return resp, nil
@@ -42,16 +39,13 @@ func (c *Client) GetServerTime(ctx context.Context) (*openapi.ResultGetServerTim
 
 // GetMetaData gets the exchange metadata
 func (c *Client) GetMetaData(ctx context.Context) (*openapi.ResultMetaData, error) {
-	resp, _, err := c.openapiClient.Class00MetaDataPublicApiAPI.GetMetaData(ctx).Execute()
+	resp, httpResp, err := c.openapiClient.Class00MetaDataPublicApiAPI.GetMetaData(ctx).Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get metadata: %w", err)
+		return nil, fmt.Errorf("failed to get metadata: %w", internal.ParseError(err, httpResp))
 	}
 
-	if resp.GetCode() != "SUCCESS" {
-		if errorParam := resp.GetErrorParam(); errorParam != nil {
-			return nil, fmt.Errorf("request failed with error params: %v", errorParam)
-		}
-		return nil, fmt.Errorf("request failed with code: %s", resp.GetCode())
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
 	}
 
 	return resp, nil
@@ -0,0 +1,129 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+
+	openapi "github.com/edgex-Tech/edgex-golang-sdk/openapi"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/internal"
+)
+
+// DefaultBatchConcurrency is the default number of orders of a batch in flight at once
+const DefaultBatchConcurrency = 8
+
+// CreateOrderResult holds the outcome of one order of a batch
+type CreateOrderResult struct {
+	Params *CreateOrderParams         // Copy of the order parameters with defaults applied
+	Result *openapi.ResultCreateOrder // Nil if the order failed
+	Err    error                      // Error of the order, nil on success
+}
+
+// CancelOrdersParams represents parameters for canceling several orders in one request
+type CancelOrdersParams struct {
+	OrderIds       []string // Order IDs to cancel
+	ClientOrderIds []string // Client order IDs to cancel
+}
+
+// CancelOrdersResult holds the cancel result of each order, keyed by the given ID
+type CancelOrdersResult struct {
+	ByOrderId       map[string]string
+	ByClientOrderId map[string]string
+}
+
+// CreateOrders signs and submits several orders concurrently, with at most
+// concurrency orders in flight. A non-positive concurrency uses DefaultBatchConcurrency.
+// The results are in the order of params. The returned error joins the errors of
+// all failed orders and is nil if every order was created.
+func (c *Client) CreateOrders(ctx context.Context, params []CreateOrderParams, metadata openapi.MetaData, concurrency int) ([]CreateOrderResult, error) {
+	if concurrency <= 0 {
+		concurrency = DefaultBatchConcurrency
+	}
+
+	results := make([]CreateOrderResult, len(params))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i := range params {
+		results[i].Params = &params[i]
+		resolved, err := withDefaults(&params[i], metadata)
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+		results[i].Params = resolved
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			results[i].Err = ctx.Err()
+			continue
+		}
+
+		wg.Add(1)
+		go func(result *CreateOrderResult) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			result.Result, result.Err = c.CreateOrder(ctx, result.Params, metadata)
+		}(&results[i])
+	}
+	wg.Wait()
+
+	var errs []error
+	for i, result := range results {
+		if result.Err != nil {
+			errs = append(errs, fmt.Errorf("order %d: %w", i, result.Err))
+		}
+	}
+	return results, errors.Join(errs...)
+}
+
+// CancelOrders cancels several orders by order ID and client order ID. Each kind of ID
+// is canceled in a single request.
+func (c *Client) CancelOrders(ctx context.Context, params *CancelOrdersParams) (*CancelOrdersResult, error) {
+	if len(params.OrderIds) == 0 && len(params.ClientOrderIds) == 0 {
+		return nil, fmt.Errorf("must provide OrderIds or ClientOrderIds")
+	}
+
+	// Cancels are idempotent and can be retried
+	ctx = internal.WithIdempotent(ctx)
+	accountID := strconv.FormatInt(c.GetAccountID(), 10)
+	result := &CancelOrdersResult{}
+
+	if len(params.OrderIds) > 0 {
+		req := c.openapiClient.Class04OrderPrivateApiAPI.CancelOrderById(ctx).
+			CancelOrderByIdParam(openapi.CancelOrderByIdParam{
+				AccountId:   &accountID,
+				OrderIdList: params.OrderIds,
+			})
+		resp, httpResp, err := req.Execute()
+		if err != nil {
+			return nil, fmt.Errorf("failed to cancel orders: %w", internal.ParseError(err, httpResp))
+		}
+		if err := internal.CheckResponse(resp, httpResp); err != nil {
+			return nil, err
+		}
+		data := resp.GetData()
+		result.ByOrderId = data.GetCancelResultMap()
+	}
+
+	if len(params.ClientOrderIds) > 0 {
+		req := c.openapiClient.Class04OrderPrivateApiAPI.CancelOrderByClientOrderId(ctx).
+			CancelOrderByClientOrderIdParam(openapi.CancelOrderByClientOrderIdParam{
+				AccountId:         &accountID,
+				ClientOrderIdList: params.ClientOrderIds,
+			})
+		resp, httpResp, err := req.Execute()
+		if err != nil {
+			return result, fmt.Errorf("failed to cancel orders by client order id: %w", internal.ParseError(err, httpResp))
+		}
+		if err := internal.CheckResponse(resp, httpResp); err != nil {
+			return result, err
+		}
+		data := resp.GetData()
+		result.ByClientOrderId = data.GetCancelResultMap()
+	}
+
+	return result, nil
+}
@@ -2,6 +2,7 @@ package order
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -9,6 +10,7 @@ import (
 
 	openapi "github.com/edgex-Tech/edgex-golang-sdk/openapi"
 	"github.com/edgex-Tech/edgex-golang-sdk/sdk/internal"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/market"
 	"github.com/shopspring/decimal"
 )
 
@@ -16,6 +18,8 @@ import (
 type Client struct {
 	*internal.Client
 	openapiClient *openapi.APIClient
+	tradeSettings tradeSettingCache
+	prices        priceCache
 }
 
 // NewClient creates a new order client
@@ -28,94 +32,231 @@ func NewClient(client *internal.Client, openapiClient *openapi.APIClient) *Clien
 
 // CreateOrder creates a new order with the given parameters
 func (c *Client) CreateOrder(ctx context.Context, params *CreateOrderParams, metadata openapi.MetaData) (*openapi.ResultCreateOrder, error) {
+	ctx, createOrderParam, err := c.buildCreateOrderParam(ctx, params, metadata)
+	if err != nil {
+		return nil, err
+	}
+	return c.submitOrder(ctx, createOrderParam)
+}
+
+// withDefaults returns a copy of params with the defaults of the order type applied:
+// the time in force, the trigger price type and the worst price of conditional market
+// orders without a price. params is not modified, so it can be reused for other orders.
+func withDefaults(params *CreateOrderParams, metadata openapi.MetaData) (*CreateOrderParams, error) {
+	copied := *params
+	params = &copied
+
 	// Set default TimeInForce based on order type if not specified
 	if params.TimeInForce == "" {
 		switch params.Type {
-		case OrderTypeMarket:
+		case OrderTypeMarket, OrderTypeStopMarket, OrderTypeTakeProfitMarket:
 			params.TimeInForce = string(TimeInForce_IMMEDIATE_OR_CANCEL)
-		case OrderTypeLimit:
+		case OrderTypeLimit, OrderTypeStopLimit, OrderTypeTakeProfitLimit:
 			params.TimeInForce = string(TimeInForce_GOOD_TIL_CANCEL)
 		}
 	}
 
-	// Find the contract from metadata
-	var contract *openapi.Contract
-	contractList := metadata.GetContractList()
-	for i := range contractList {
-		if contractList[i].GetContractId() == params.ContractId {
-			contract = &contractList[i]
-			break
+	// Conditional orders need a trigger price
+	if params.Type.IsConditional() {
+		if params.TriggerPrice == "" {
+			return nil, fmt.Errorf("trigger price is required for %s orders", params.Type)
+		}
+		if params.TriggerPriceType == "" {
+			params.TriggerPriceType = TriggerPriceTypeLast
 		}
 	}
-	if contract == nil {
-		return nil, fmt.Errorf("contract not found: %s", params.ContractId)
+
+	if params.Type.IsConditional() && params.Type.IsMarket() && params.Price == "" {
+		contract, _, err := findContract(metadata, params.ContractId)
+		if err != nil {
+			return nil, err
+		}
+		mkt, err := market.NewMarket(*contract)
+		if err != nil {
+			return nil, err
+		}
+		triggerPrice, err := decimal.NewFromString(params.TriggerPrice)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse trigger price: %w", err)
+		}
+		params.Price = worstPrice(mkt, triggerPrice, params.Side).String()
 	}
+	return params, nil
+}
 
-	// Get collateral coin from metadata
-	global := metadata.GetGlobal()
-	collateralCoin := global.GetStarkExCollateralCoin()
+// buildCreateOrderParam validates and signs an order and returns the request body.
+// The returned context is marked idempotent when the caller supplied a client order ID.
+func (c *Client) buildCreateOrderParam(ctx context.Context, params *CreateOrderParams, metadata openapi.MetaData) (context.Context, *openapi.CreateOrderParam, error) {
+	params, err := withDefaults(params, metadata)
+	if err != nil {
+		return ctx, nil, err
+	}
+
+	contract, collateralCoin, err := findContract(metadata, params.ContractId)
+	if err != nil {
+		return ctx, nil, err
+	}
+
+	// Build typed market rules
+	mkt, err := market.NewMarket(*contract)
+	if err != nil {
+		return ctx, nil, err
+	}
 
 	// Parse decimal values
 	size, err := decimal.NewFromString(params.Size)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse size: %w", err)
+		return ctx, nil, fmt.Errorf("failed to parse size: %w", err)
 	}
 
 	price, err := decimal.NewFromString(params.Price)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse price: %w", err)
+		return ctx, nil, fmt.Errorf("failed to parse price: %w", err)
 	}
 
-	// Convert hex resolution to decimal
-	hexResolution := contract.GetStarkExResolution()
-	// Remove "0x" prefix if present
-	hexResolution = strings.TrimPrefix(hexResolution, "0x")
-	// Parse hex string to int64
-	resolutionInt, err := strconv.ParseInt(hexResolution, 16, 64)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse hex resolution: %w", err)
+	// Reject invalid orders before signing
+	if !params.SkipValidation {
+		if err := c.validateOrder(ctx, params, mkt, price, size); err != nil {
+			return ctx, nil, err
+		}
 	}
-	resolution := decimal.NewFromInt(resolutionInt)
 
 	clientOrderId := internal.GenerateUUID()
 	if params.ClientOrderId != nil {
 		clientOrderId = *params.ClientOrderId
+		// The server deduplicates on client order ID, so the request can be retried
+		ctx = internal.WithIdempotent(ctx)
 	}
 
-	// Calculate values
-	valueDm := price.Mul(size)
-	amountSynthetic := size.Mul(resolution).IntPart()
-	amountCollateral := valueDm.Shift(6).IntPart()
+	expiry, err := internal.ResolveExpiry(time.Now(), params.L2ExpireTime, params.ExpireTime, params.ExpireAfter)
+	if err != nil {
+		return ctx, nil, err
+	}
+
+	rates, err := c.resolveFeeRates(ctx, mkt)
+	if err != nil {
+		return ctx, nil, err
+	}
 
-	// Calculate fee based on order type (maker/taker)
-	feeRate, err := decimal.NewFromString(contract.GetDefaultTakerFeeRate())
+	signed, err := c.signLimitOrder(contract, collateralCoin, mkt, params.Side, price, size, feeRate(rates, params.TimeInForce), clientOrderId, expiry)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse fee rate: %w", err)
+		return ctx, nil, err
 	}
 
+	// Create order request
+	accountID := strconv.FormatInt(c.Client.GetAccountID(), 10)
+
+	var price_ string
+	if !params.Type.IsMarket() {
+		price_ = params.Price
+	} else {
+		price_ = "0"
+	}
+
+	createOrderParam := &openapi.CreateOrderParam{
+		AccountId:     &accountID,
+		ContractId:    &params.ContractId,
+		Price:         &price_,
+		Size:          &params.Size,
+		Type:          (*string)(&params.Type),
+		TimeInForce:   &params.TimeInForce,
+		Side:          &params.Side,
+		L2Signature:   &signed.Signature,
+		L2Nonce:       &signed.Nonce,
+		L2ExpireTime:  &signed.L2ExpireTime,
+		L2Value:       &signed.Value,
+		L2Size:        &params.Size,
+		L2LimitFee:    &signed.LimitFee,
+		ClientOrderId: &clientOrderId,
+		ExpireTime:    &signed.ExpireTime,
+		ReduceOnly:    &params.ReduceOnly,
+	}
+	if params.Type.IsConditional() {
+		createOrderParam.SetTriggerPrice(params.TriggerPrice)
+		createOrderParam.SetTriggerPriceType(string(params.TriggerPriceType))
+	}
+	if params.IsPositionTpsl {
+		createOrderParam.SetIsPositionTpsl(true)
+	}
+
+	return ctx, createOrderParam, nil
+}
+
+// submitOrder sends a signed order to the exchange
+func (c *Client) submitOrder(ctx context.Context, createOrderParam *openapi.CreateOrderParam) (*openapi.ResultCreateOrder, error) {
+	req := c.openapiClient.Class04OrderPrivateApiAPI.CreateOrder(ctx).
+		CreateOrderParam(*createOrderParam)
+
+	// Execute request
+	resp, httpResp, err := req.Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create order: %w", internal.ParseError(err, httpResp))
+	}
+
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// findContract finds a contract and the collateral coin in the metadata
+func findContract(metadata openapi.MetaData, contractId string) (*openapi.Contract, *openapi.Coin, error) {
+	var contract *openapi.Contract
+	contractList := metadata.GetContractList()
+	for i := range contractList {
+		if contractList[i].GetContractId() == contractId {
+			contract = &contractList[i]
+			break
+		}
+	}
+	if contract == nil {
+		return nil, nil, fmt.Errorf("contract not found: %s", contractId)
+	}
+
+	// Get collateral coin from metadata
+	global := metadata.GetGlobal()
+	collateralCoin := global.GetStarkExCollateralCoin()
+	return contract, &collateralCoin, nil
+}
+
+// l2Order holds the L2 fields of a signed limit order
+type l2Order struct {
+	Nonce        string
+	Value        string
+	LimitFee     string
+	L2ExpireTime string
+	ExpireTime   string
+	Signature    string
+}
+
+// signLimitOrder computes and signs the L2 limit order hash of an order
+func (c *Client) signLimitOrder(contract *openapi.Contract, collateralCoin *openapi.Coin, mkt *market.Market, side string, price, size, feeRate decimal.Decimal, clientOrderId string, expiry internal.Expiry) (*l2Order, error) {
+	// Calculate values
+	valueDm := price.Mul(size)
+	amountSynthetic := mkt.ToSyntheticAmount(size)
+	amountCollateral := valueDm.Shift(6).IntPart()
+
 	// Calculate fee amount in decimal with 6 decimal places
 	amountFeeDm := valueDm.Mul(feeRate).Round(6)
-	amountFeeStr := amountFeeDm.String()
 
 	// Convert to the required integer format for the protocol
 	amountFee := amountFeeDm.Shift(6).IntPart()
 
 	nonce := internal.CalcNonce(clientOrderId)
-	l2ExpireTime := time.Now().Add(14 * 24 * time.Hour).UnixMilli()
 
 	// Calculate signature using asset IDs from metadata
-	expireTimeUnix := l2ExpireTime / (60 * 60 * 1000)
 	sigHash := internal.CalcLimitOrderHash(
 		contract.GetStarkExSyntheticAssetId(),
 		collateralCoin.GetStarkExAssetId(),
 		collateralCoin.GetStarkExAssetId(),
-		params.Side == OrderSideBuy,
+		side == OrderSideBuy,
 		amountSynthetic,
 		amountCollateral,
 		amountFee,
 		nonce,
 		c.Client.GetAccountID(),
-		expireTimeUnix,
+		expiry.L2ExpireHour(),
 	)
 
 	// Sign the order
@@ -124,61 +265,30 @@ func (c *Client) CreateOrder(ctx context.Context, params *CreateOrderParams, met
 		return nil, fmt.Errorf("failed to sign order: %w", err)
 	}
 
-	// Convert signature to string
-	sigStr := fmt.Sprintf("%s%s%s", sig.R, sig.S, sig.V)
-
-	// Create order request
-	accountID := strconv.FormatInt(c.Client.GetAccountID(), 10)
-	nonceStr := strconv.FormatInt(nonce, 10)
-	l2ExpireTimeStr := strconv.FormatInt(l2ExpireTime, 10)
-	expireTimeStr := strconv.FormatInt(l2ExpireTime-864000000, 10)
-	valueStr := valueDm.String()
-
-	var price_ string
-	if string(params.Type) == string(OrderTypeLimit) {
-		price_ = params.Price
-	} else {
-		price_ = "0"
-	}
-
-	req := c.openapiClient.Class04OrderPrivateApiAPI.CreateOrder(ctx).
-		CreateOrderParam(openapi.CreateOrderParam{
-			AccountId:     &accountID,
-			ContractId:    &params.ContractId,
-			Price:         &price_,
-			Size:          &params.Size,
-			Type:          (*string)(&params.Type),
-			TimeInForce:   &params.TimeInForce,
-			Side:          &params.Side,
-			L2Signature:   &sigStr,
-			L2Nonce:       &nonceStr,
-			L2ExpireTime:  &l2ExpireTimeStr,
-			L2Value:       &valueStr,
-			L2Size:        &params.Size,
-			L2LimitFee:    &amountFeeStr,
-			ClientOrderId: &clientOrderId,
-			ExpireTime:    &expireTimeStr,
-			ReduceOnly:    &params.ReduceOnly,
-		})
-
-	// Execute request
-	resp, _, err := req.Execute()
-	if err != nil {
-		return nil, fmt.Errorf("failed to create order: %w", err)
-	}
+	return &l2Order{
+		Nonce:        strconv.FormatInt(nonce, 10),
+		Value:        valueDm.String(),
+		LimitFee:     amountFeeDm.String(),
+		L2ExpireTime: strconv.FormatInt(expiry.L2ExpireTime, 10),
+		ExpireTime:   strconv.FormatInt(expiry.ExpireTime, 10),
+		Signature:    fmt.Sprintf("%s%s%s", sig.R, sig.S, sig.V),
+	}, nil
+}
 
-	if resp.GetCode() != ResponseCodeSuccess {
-		if errorParam := resp.GetErrorParam(); errorParam != nil {
-			return nil, fmt.Errorf("request failed with error params: %v", errorParam)
-		}
-		return nil, fmt.Errorf("request failed with code: %s", resp.GetCode())
+// worstPrice returns the worst execution price of a market order triggered at
+// the given price: ten times the trigger price for buys and the tick size for sells
+func worstPrice(mkt *market.Market, triggerPrice decimal.Decimal, side string) decimal.Decimal {
+	if side == OrderSideBuy {
+		return mkt.RoundPrice(triggerPrice.Mul(decimal.NewFromInt(10)), side)
 	}
-
-	return resp, nil
+	return mkt.TickSize
 }
 
 // CancelOrder cancels a specific order
 func (c *Client) CancelOrder(ctx context.Context, params *CancelOrderParams) (interface{}, error) {
+	// Cancels are idempotent and can be retried
+	ctx = internal.WithIdempotent(ctx)
+
 	if params.OrderId != "" {
 		req := c.openapiClient.Class04OrderPrivateApiAPI.CancelOrderById(ctx)
 		accountID := strconv.FormatInt(c.GetAccountID(), 10)
@@ -187,15 +297,12 @@ func (c *Client) CancelOrder(ctx context.Context, params *CancelOrderParams) (in
 			OrderIdList: []string{params.OrderId},
 		}
 		req = req.CancelOrderByIdParam(cancelParam)
-		resp, _, err := req.Execute()
+		resp, httpResp, err := req.Execute()
 		if err != nil {
-			return nil, err
+			return nil, internal.ParseError(err, httpResp)
 		}
-		if resp.GetCode() != ResponseCodeSuccess {
-			if errorParam := resp.GetErrorParam(); errorParam != nil {
-				return nil, fmt.Errorf("request failed with error params: %v", errorParam)
-			}
-			return nil, fmt.Errorf("request failed with code: %s", resp.GetCode())
+		if err := internal.CheckResponse(resp, httpResp); err != nil {
+			return nil, err
 		}
 		return resp, nil
 	} else if params.ClientId != "" {
@@ -206,15 +313,12 @@ func (c *Client) CancelOrder(ctx context.Context, params *CancelOrderParams) (in
 			ClientOrderIdList: []string{params.ClientId},
 		}
 		req = req.CancelOrderByClientOrderIdParam(cancelParam)
-		resp, _, err := req.Execute()
+		resp, httpResp, err := req.Execute()
 		if err != nil {
-			return nil, err
+			return nil, internal.ParseError(err, httpResp)
 		}
-		if resp.GetCode() != ResponseCodeSuccess {
-			if errorParam := resp.GetErrorParam(); errorParam != nil {
-				return nil, fmt.Errorf("request failed with error params: %v", errorParam)
-			}
-			return nil, fmt.Errorf("request failed with code: %s", resp.GetCode())
+		if err := internal.CheckResponse(resp, httpResp); err != nil {
+			return nil, err
 		}
 		return resp, nil
 	} else if params.ContractId != "" {
@@ -225,15 +329,12 @@ func (c *Client) CancelOrder(ctx context.Context, params *CancelOrderParams) (in
 			FilterContractIdList: []string{params.ContractId},
 		}
 		req = req.CancelAllOrderParam(cancelParam)
-		resp, _, err := req.Execute()
+		resp, httpResp, err := req.Execute()
 		if err != nil {
-			return nil, err
+			return nil, internal.ParseError(err, httpResp)
 		}
-		if resp.GetCode() != ResponseCodeSuccess {
-			if errorParam := resp.GetErrorParam(); errorParam != nil {
-				return nil, fmt.Errorf("request failed with error params: %v", errorParam)
-			}
-			return nil, fmt.Errorf("request failed with code: %s", resp.GetCode())
+		if err := internal.CheckResponse(resp, httpResp); err != nil {
+			return nil, err
 		}
 		return resp, nil
 	}
@@ -286,15 +387,12 @@ func (c *Client) GetActiveOrders(ctx context.Context, params *GetActiveOrderPara
 		req = req.FilterEndCreatedTimeExclusive(strconv.FormatUint(params.FilterEndCreatedTimeExclusive, 10))
 	}
 
-	resp, _, err := req.Execute()
+	resp, httpResp, err := req.Execute()
 	if err != nil {
-		return nil, err
+		return nil, internal.ParseError(err, httpResp)
 	}
-	if resp.GetCode() != ResponseCodeSuccess {
-		if errorParam := resp.GetErrorParam(); errorParam != nil {
-			return nil, fmt.Errorf("request failed with error params: %v", errorParam)
-		}
-		return nil, fmt.Errorf("request failed with code: %s", resp.GetCode())
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
 	}
 	return resp, nil
 }
@@ -342,15 +440,12 @@ func (c *Client) GetOrderFillTransactions(ctx context.Context, params *OrderFill
 		req = req.FilterEndCreatedTimeExclusive(strconv.FormatUint(params.FilterEndCreatedTimeExclusive, 10))
 	}
 
-	resp, _, err := req.Execute()
+	resp, httpResp, err := req.Execute()
 	if err != nil {
-		return nil, err
+		return nil, internal.ParseError(err, httpResp)
 	}
-	if resp.GetCode() != ResponseCodeSuccess {
-		if errorParam := resp.GetErrorParam(); errorParam != nil {
-			return nil, fmt.Errorf("request failed with error params: %v", errorParam)
-		}
-		return nil, fmt.Errorf("request failed with code: %s", resp.GetCode())
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
 	}
 	return resp, nil
 }
@@ -365,20 +460,18 @@ func (c *Client) GetMaxOrderSize(ctx context.Context, contractID string, price f
 		ContractId: &contractID,
 		Price:      &priceStr,
 	}
-	resp, _, err := req.GetMaxCreateOrderSizeParam(param).Execute()
+	resp, httpResp, err := req.GetMaxCreateOrderSizeParam(param).Execute()
 	if err != nil {
-		return nil, err
+		return nil, internal.ParseError(err, httpResp)
 	}
-	if resp.GetCode() != ResponseCodeSuccess {
-		if errorParam := resp.GetErrorParam(); errorParam != nil {
-			return nil, fmt.Errorf("request failed with error params: %v", errorParam)
-		}
-		return nil, fmt.Errorf("request failed with code: %s", resp.GetCode())
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
 	}
 	return resp, nil
 }
 
-// GetOrder retrieves an order by either OrderId or ClientId
+// GetOrder retrieves an order by either OrderId or ClientId. Orders that are no
+// longer active are looked up in the order history.
 func (c *Client) GetOrder(ctx context.Context, params *GetOrderParams) (*openapi.ResultListOrder, error) {
 	if params.OrderId != "" {
 		req := c.openapiClient.Class04OrderPrivateApiAPI.GetOrderById(ctx)
@@ -386,15 +479,17 @@ func (c *Client) GetOrder(ctx context.Context, params *GetOrderParams) (*openapi
 
 		req = req.AccountId(accountID)
 		req = req.OrderIdList(params.OrderId)
-		resp, _, err := req.Execute()
+		resp, httpResp, err := req.Execute()
 		if err != nil {
-			return nil, err
+			err = internal.ParseError(err, httpResp)
+		} else {
+			err = internal.CheckResponse(resp, httpResp)
 		}
-		if resp.GetCode() != ResponseCodeSuccess {
-			if errorParam := resp.GetErrorParam(); errorParam != nil {
-				return nil, fmt.Errorf("request failed with error params: %v", errorParam)
-			}
-			return nil, fmt.Errorf("request failed with code: %s", resp.GetCode())
+		if errors.Is(err, internal.ErrOrderNotFound) || (err == nil && len(resp.GetData()) == 0) {
+			return c.GetHistoryOrderById(ctx, []string{params.OrderId})
+		}
+		if err != nil {
+			return nil, err
 		}
 		return resp, nil
 	} else if params.ClientId != "" {
@@ -402,17 +497,120 @@ func (c *Client) GetOrder(ctx context.Context, params *GetOrderParams) (*openapi
 		accountID := strconv.FormatInt(c.GetAccountID(), 10)
 		req = req.AccountId(accountID)
 		req = req.ClientOrderIdList(params.ClientId)
-		resp, _, err := req.Execute()
+		resp, httpResp, err := req.Execute()
 		if err != nil {
-			return nil, err
+			err = internal.ParseError(err, httpResp)
+		} else {
+			err = internal.CheckResponse(resp, httpResp)
+		}
+		if errors.Is(err, internal.ErrOrderNotFound) || (err == nil && len(resp.GetData()) == 0) {
+			return c.GetHistoryOrderByClientOrderId(ctx, []string{params.ClientId})
 		}
-		if resp.GetCode() != ResponseCodeSuccess {
-			if errorParam := resp.GetErrorParam(); errorParam != nil {
-				return nil, fmt.Errorf("request failed with error params: %v", errorParam)
-			}
-			return nil, fmt.Errorf("request failed with code: %s", resp.GetCode())
+		if err != nil {
+			return nil, err
 		}
 		return resp, nil
 	}
 	return nil, fmt.Errorf("must provide either OrderId, ClientId")
 }
+
+// GetHistoryOrderPage gets historical orders with pagination and filters
+func (c *Client) GetHistoryOrderPage(ctx context.Context, params *GetHistoryOrderParams) (*openapi.ResultPageDataOrder, error) {
+	req := c.openapiClient.Class04OrderPrivateApiAPI.GetHistoryOrderPage(ctx)
+
+	// Set account ID and pagination
+	req = req.AccountId(strconv.FormatInt(c.GetAccountID(), 10))
+	if params.Size != "" {
+		req = req.Size(params.Size)
+	}
+	if params.OffsetData != "" {
+		req = req.OffsetData(params.OffsetData)
+	}
+
+	// Set filters
+	if len(params.FilterCoinIdList) > 0 {
+		req = req.FilterCoinIdList(strings.Join(params.FilterCoinIdList, ","))
+	}
+	if len(params.FilterContractIdList) > 0 {
+		req = req.FilterContractIdList(strings.Join(params.FilterContractIdList, ","))
+	}
+	if len(params.FilterTypeList) > 0 {
+		req = req.FilterTypeList(strings.Join(params.FilterTypeList, ","))
+	}
+	if len(params.FilterStatusList) > 0 {
+		req = req.FilterStatusList(strings.Join(params.FilterStatusList, ","))
+	}
+
+	// Set boolean filters
+	if params.FilterIsLiquidate != nil {
+		req = req.FilterIsLiquidateList(strconv.FormatBool(*params.FilterIsLiquidate))
+	}
+	if params.FilterIsDeleverage != nil {
+		req = req.FilterIsDeleverageList(strconv.FormatBool(*params.FilterIsDeleverage))
+	}
+	if params.FilterIsPositionTpsl != nil {
+		req = req.FilterIsPositionTpslList(strconv.FormatBool(*params.FilterIsPositionTpsl))
+	}
+
+	// Set time filters
+	if params.FilterStartCreatedTimeInclusive > 0 {
+		req = req.FilterStartCreatedTimeInclusive(strconv.FormatUint(params.FilterStartCreatedTimeInclusive, 10))
+	}
+	if params.FilterEndCreatedTimeExclusive > 0 {
+		req = req.FilterEndCreatedTimeExclusive(strconv.FormatUint(params.FilterEndCreatedTimeExclusive, 10))
+	}
+
+	resp, httpResp, err := req.Execute()
+	if err != nil {
+		return nil, internal.ParseError(err, httpResp)
+	}
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetHistoryOrderById gets historical orders by order IDs
+func (c *Client) GetHistoryOrderById(ctx context.Context, orderIds []string) (*openapi.ResultListOrder, error) {
+	req := c.openapiClient.Class04OrderPrivateApiAPI.GetHistoryOrderById(ctx).
+		AccountId(strconv.FormatInt(c.GetAccountID(), 10)).
+		OrderIdList(strings.Join(orderIds, ","))
+	resp, httpResp, err := req.Execute()
+	if err != nil {
+		return nil, internal.ParseError(err, httpResp)
+	}
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetHistoryOrderByClientOrderId gets historical orders by client order IDs
+func (c *Client) GetHistoryOrderByClientOrderId(ctx context.Context, clientOrderIds []string) (*openapi.ResultListOrder, error) {
+	req := c.openapiClient.Class04OrderPrivateApiAPI.GetHistoryOrderByClientOrderId(ctx).
+		AccountId(strconv.FormatInt(c.GetAccountID(), 10)).
+		ClientOrderIdList(strings.Join(clientOrderIds, ","))
+	resp, httpResp, err := req.Execute()
+	if err != nil {
+		return nil, internal.ParseError(err, httpResp)
+	}
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetHistoryOrderFillTransactionById gets historical order fill transactions by IDs
+func (c *Client) GetHistoryOrderFillTransactionById(ctx context.Context, fillTransactionIds []string) (*openapi.ResultListOrderFillTransaction, error) {
+	req := c.openapiClient.Class04OrderPrivateApiAPI.GetHistoryOrderFillTransactionById(ctx).
+		AccountId(strconv.FormatInt(c.GetAccountID(), 10)).
+		OrderFillTransactionIdList(strings.Join(fillTransactionIds, ","))
+	resp, httpResp, err := req.Execute()
+	if err != nil {
+		return nil, internal.ParseError(err, httpResp)
+	}
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
@@ -0,0 +1,208 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	openapi "github.com/edgex-Tech/edgex-golang-sdk/openapi"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/internal"
+)
+
+// CancelAllParams represents filters for canceling all active orders.
+// Empty filters match everything, so zero params cancel all orders of the account.
+type CancelAllParams struct {
+	FilterCoinIdList      []string // Filter by collateral coin IDs
+	FilterContractIdList  []string // Filter by contract IDs
+	FilterOrderTypeList   []string // Filter by order types
+	FilterOrderStatusList []string // Filter by order statuses
+	FilterIsPositionTpsl  []bool   // Filter by position take-profit/stop-loss status
+}
+
+// CancelAll cancels all active orders matching the filters. A nil params cancels
+// all active orders of the account.
+func (c *Client) CancelAll(ctx context.Context, params *CancelAllParams) (*openapi.ResultCancelOrder, error) {
+	if params == nil {
+		params = &CancelAllParams{}
+	}
+
+	// Cancels are idempotent and can be retried
+	ctx = internal.WithIdempotent(ctx)
+	accountID := strconv.FormatInt(c.GetAccountID(), 10)
+	req := c.openapiClient.Class04OrderPrivateApiAPI.CancelAllOrder(ctx).
+		CancelAllOrderParam(openapi.CancelAllOrderParam{
+			AccountId:             &accountID,
+			FilterCoinIdList:      params.FilterCoinIdList,
+			FilterContractIdList:  params.FilterContractIdList,
+			FilterOrderTypeList:   params.FilterOrderTypeList,
+			FilterOrderStatusList: params.FilterOrderStatusList,
+			FilterIsPositionTpsl:  params.FilterIsPositionTpsl,
+		})
+	resp, httpResp, err := req.Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel all orders: %w", internal.ParseError(err, httpResp))
+	}
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Reasons passed to DeadManSwitchConfig.OnTrigger
+const (
+	DeadManReasonHeartbeat  = "heartbeat timeout"
+	DeadManReasonDisconnect = "websocket disconnected"
+)
+
+// DeadManSwitchConfig configures a DeadManSwitch
+type DeadManSwitchConfig struct {
+	// HeartbeatTimeout cancels all orders when Heartbeat is not called within it, 0 disables
+	HeartbeatTimeout time.Duration
+	// DisconnectTimeout cancels all orders when the connection is down longer than it, 0 disables
+	DisconnectTimeout time.Duration
+	// CheckInterval is how often the timeouts are checked, 0 uses a quarter of the shortest timeout
+	CheckInterval time.Duration
+	// CancelTimeout bounds the cancel request, 0 uses 10 seconds
+	CancelTimeout time.Duration
+	// Filters limits the orders canceled, nil cancels all orders
+	Filters *CancelAllParams
+	// OnTrigger is called after the switch fired with the reason and the cancel outcome
+	OnTrigger func(reason string, result *openapi.ResultCancelOrder, err error)
+}
+
+// DeadManSwitch cancels all open orders when the caller stops sending heartbeats or
+// the connection stays down for too long. Each timeout fires once and re-arms
+// separately: the heartbeat timeout on the next heartbeat and the disconnect timeout
+// on the next reconnect, so heartbeats during an outage do not cancel again.
+type DeadManSwitch struct {
+	client *Client
+	cfg    DeadManSwitchConfig
+
+	mu                  sync.Mutex
+	lastHeartbeat       time.Time
+	connected           bool
+	disconnectedAt      time.Time
+	heartbeatTriggered  bool // The heartbeat timeout fired since the last heartbeat
+	disconnectTriggered bool // The disconnect timeout fired since the last reconnect
+
+	stop     chan struct{}
+	stopOnce sync.Once
+	done     chan struct{}
+}
+
+// NewDeadManSwitch creates a dead man's switch. Call Start to arm it.
+func (c *Client) NewDeadManSwitch(cfg DeadManSwitchConfig) *DeadManSwitch {
+	if cfg.CheckInterval <= 0 {
+		shortest := cfg.HeartbeatTimeout
+		if shortest <= 0 || (cfg.DisconnectTimeout > 0 && cfg.DisconnectTimeout < shortest) {
+			shortest = cfg.DisconnectTimeout
+		}
+		cfg.CheckInterval = shortest / 4
+		if cfg.CheckInterval <= 0 {
+			cfg.CheckInterval = time.Second
+		}
+	}
+	if cfg.CancelTimeout <= 0 {
+		cfg.CancelTimeout = 10 * time.Second
+	}
+	return &DeadManSwitch{
+		client:    c,
+		cfg:       cfg,
+		connected: true,
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+}
+
+// Start arms the switch and starts the background watcher
+func (d *DeadManSwitch) Start() {
+	d.Heartbeat()
+	go d.run()
+}
+
+// Stop disarms the switch and waits for the background watcher to exit
+func (d *DeadManSwitch) Stop() {
+	d.stopOnce.Do(func() {
+		close(d.stop)
+	})
+	<-d.done
+}
+
+// Heartbeat signals that the caller is alive
+func (d *DeadManSwitch) Heartbeat() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.lastHeartbeat = time.Now()
+	d.heartbeatTriggered = false
+}
+
+// SetConnected reports the state of the watched connection, e.g. from the
+// OnConnect and OnDisconnect hooks of the private WebSocket
+func (d *DeadManSwitch) SetConnected(connected bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if connected == d.connected {
+		return
+	}
+	d.connected = connected
+	if connected {
+		d.disconnectTriggered = false
+	} else {
+		d.disconnectedAt = time.Now()
+	}
+}
+
+// run checks the timeouts until the switch is stopped
+func (d *DeadManSwitch) run() {
+	defer close(d.done)
+	ticker := time.NewTicker(d.cfg.CheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-d.stop:
+			return
+		case <-ticker.C:
+			if reason := d.check(time.Now()); reason != "" {
+				d.fire(reason)
+			}
+		}
+	}
+}
+
+// check returns the reason to fire, or an empty string
+func (d *DeadManSwitch) check(now time.Time) string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !d.heartbeatTriggered && d.cfg.HeartbeatTimeout > 0 && now.Sub(d.lastHeartbeat) > d.cfg.HeartbeatTimeout {
+		d.heartbeatTriggered = true
+		return DeadManReasonHeartbeat
+	}
+	if !d.disconnectTriggered && d.cfg.DisconnectTimeout > 0 && !d.connected && now.Sub(d.disconnectedAt) > d.cfg.DisconnectTimeout {
+		d.disconnectTriggered = true
+		return DeadManReasonDisconnect
+	}
+	return ""
+}
+
+// fire cancels all orders matching the filters
+func (d *DeadManSwitch) fire(reason string) {
+	ctx, cancel := context.WithTimeout(context.Background(), d.cfg.CancelTimeout)
+	defer cancel()
+
+	result, err := d.client.CancelAll(ctx, d.cfg.Filters)
+	if err != nil {
+		// Try again on the next check
+		d.mu.Lock()
+		if reason == DeadManReasonHeartbeat {
+			d.heartbeatTriggered = false
+		} else {
+			d.disconnectTriggered = false
+		}
+		d.mu.Unlock()
+	}
+	if d.cfg.OnTrigger != nil {
+		d.cfg.OnTrigger(reason, result, err)
+	}
+}
@@ -0,0 +1,166 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	openapi "github.com/edgex-Tech/edgex-golang-sdk/openapi"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/internal"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/market"
+	"github.com/shopspring/decimal"
+)
+
+// tradeSettingTTL is how long the account trade settings are cached for fee resolution
+const tradeSettingTTL = 5 * time.Minute
+
+// tradeSettingRetryAfter is how long the contract default fee rates are used after
+// the account trade settings failed to load
+const tradeSettingRetryAfter = 30 * time.Second
+
+// tradeSettingCache caches the account used to resolve fee rates
+type tradeSettingCache struct {
+	mu        sync.Mutex
+	account   *openapi.Account
+	fetchedAt time.Time
+	failedAt  time.Time
+	onError   func(err error)
+
+	fetchMu sync.Mutex // Serializes fetches, held across the request
+}
+
+// SetFeeRateErrorHandler sets a function called when the account trade settings fail
+// to load and orders fall back to the contract default fee rates. It is called once
+// per failed fetch, the orders of the following tradeSettingRetryAfter fall back too.
+func (c *Client) SetFeeRateErrorHandler(onError func(err error)) {
+	c.tradeSettings.mu.Lock()
+	defer c.tradeSettings.mu.Unlock()
+	c.tradeSettings.onError = onError
+}
+
+// GetFeeRates returns the taker and maker fee rates the account pays in a contract,
+// resolved from the account trade settings. Unlike orders it does not fall back to
+// the contract defaults, it returns the error when the trade settings cannot be loaded.
+func (c *Client) GetFeeRates(ctx context.Context, contractId string, metadata openapi.MetaData) (*market.FeeRates, error) {
+	contract, _, err := findContract(metadata, contractId)
+	if err != nil {
+		return nil, err
+	}
+	mkt, err := market.NewMarket(*contract)
+	if err != nil {
+		return nil, err
+	}
+	account, err := c.getAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return market.ResolveFeeRates(mkt, account)
+}
+
+// RefreshFeeRates drops the cached account trade settings so the next order
+// resolves its fee rate from the current settings
+func (c *Client) RefreshFeeRates() {
+	c.tradeSettings.mu.Lock()
+	defer c.tradeSettings.mu.Unlock()
+	c.tradeSettings.account = nil
+	c.tradeSettings.failedAt = time.Time{}
+}
+
+// resolveFeeRates resolves the fee rates of the account in a market. When the account
+// trade settings cannot be loaded the contract default fee rates are used, unless the
+// context is done and the order would fail anyway.
+func (c *Client) resolveFeeRates(ctx context.Context, mkt *market.Market) (*market.FeeRates, error) {
+	account, err := c.getAccount(ctx)
+	if err != nil && ctx.Err() != nil {
+		return nil, err
+	}
+	return market.ResolveFeeRates(mkt, account)
+}
+
+// feeRate returns the fee rate to sign an order with. POST_ONLY orders always
+// provide liquidity and pay the maker rate, all other orders may take liquidity.
+// Negative rates are rebates and do not need a fee limit.
+func feeRate(rates *market.FeeRates, timeInForce string) decimal.Decimal {
+	rate := rates.TakerFeeRate
+	if timeInForce == string(TimeInForce_POST_ONLY) {
+		rate = rates.MakerFeeRate
+	}
+	if rate.IsNegative() {
+		return decimal.Zero
+	}
+	return rate
+}
+
+// getAccount gets the account, cached for tradeSettingTTL. After a failed fetch no
+// fetch is made for tradeSettingRetryAfter. Fetches failing because the context is
+// done are not recorded as failed, the next order fetches again.
+func (c *Client) getAccount(ctx context.Context) (*openapi.Account, error) {
+	if account, done, err := c.tradeSettings.cached(); done {
+		return account, err
+	}
+
+	c.tradeSettings.fetchMu.Lock()
+	defer c.tradeSettings.fetchMu.Unlock()
+
+	// Another order may have fetched while we were waiting
+	if account, done, err := c.tradeSettings.cached(); done {
+		return account, err
+	}
+
+	account, err := c.fetchAccount(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			c.tradeSettings.fail(err)
+		}
+		return nil, err
+	}
+	c.tradeSettings.mu.Lock()
+	defer c.tradeSettings.mu.Unlock()
+	c.tradeSettings.account = account
+	c.tradeSettings.fetchedAt = time.Now()
+	return account, nil
+}
+
+// cached returns the cached account, or an error during the retry delay of a failed
+// fetch. done is false if the account needs to be fetched.
+func (s *tradeSettingCache) cached() (account *openapi.Account, done bool, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.account != nil && time.Since(s.fetchedAt) < tradeSettingTTL {
+		return s.account, true, nil
+	}
+	if time.Since(s.failedAt) < tradeSettingRetryAfter {
+		return nil, true, fmt.Errorf("account trade settings unavailable since %s", s.failedAt.Format(time.RFC3339))
+	}
+	return nil, false, nil
+}
+
+// fail records a failed fetch and reports it to the error handler
+func (s *tradeSettingCache) fail(err error) {
+	s.mu.Lock()
+	s.failedAt = time.Now()
+	onError := s.onError
+	s.mu.Unlock()
+
+	// Called without the lock so the handler may use the client
+	if onError != nil {
+		onError(err)
+	}
+}
+
+// fetchAccount fetches the account with its trade settings
+func (c *Client) fetchAccount(ctx context.Context) (*openapi.Account, error) {
+	resp, httpResp, err := c.openapiClient.Class03AccountPrivateApiAPI.GetAccountById(ctx).
+		AccountId(fmt.Sprintf("%d", c.GetAccountID())).
+		Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account trade settings: %w", internal.ParseError(err, httpResp))
+	}
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
+	}
+
+	account := resp.GetData()
+	return &account, nil
+}
@@ -0,0 +1,93 @@
+package order
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	openapi "github.com/edgex-Tech/edgex-golang-sdk/openapi"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/pager"
+)
+
+// ActiveOrdersPager returns a pager over all active orders matching the filters.
+// The Size and OffsetData of params are managed by the pager.
+func (c *Client) ActiveOrdersPager(params *GetActiveOrderParams, opts *pager.Options) *pager.Pager[openapi.Order] {
+	var p GetActiveOrderParams
+	if params != nil {
+		p = *params
+	}
+	return pager.New(func(ctx context.Context, offset string, size int) ([]openapi.Order, string, error) {
+		p.Size, p.OffsetData = strconv.Itoa(size), offset
+		resp, err := c.GetActiveOrders(ctx, &p)
+		if err != nil {
+			return nil, "", err
+		}
+		data := resp.GetData()
+		return data.GetDataList(), data.GetNextPageOffsetData(), nil
+	}, opts)
+}
+
+// HistoryOrdersPager returns a pager over all historical orders matching the filters.
+// The Size and OffsetData of params are managed by the pager.
+func (c *Client) HistoryOrdersPager(params *GetHistoryOrderParams, opts *pager.Options) *pager.Pager[openapi.Order] {
+	var p GetHistoryOrderParams
+	if params != nil {
+		p = *params
+	}
+	return pager.New(func(ctx context.Context, offset string, size int) ([]openapi.Order, string, error) {
+		p.Size, p.OffsetData = strconv.Itoa(size), offset
+		resp, err := c.GetHistoryOrderPage(ctx, &p)
+		if err != nil {
+			return nil, "", err
+		}
+		data := resp.GetData()
+		return data.GetDataList(), data.GetNextPageOffsetData(), nil
+	}, opts)
+}
+
+// OrderFillTransactionsPager returns a pager over all order fill transactions matching
+// the filters. The Size and OffsetData of params are managed by the pager.
+func (c *Client) OrderFillTransactionsPager(params *OrderFillTransactionParams, opts *pager.Options) *pager.Pager[openapi.OrderFillTransaction] {
+	var p OrderFillTransactionParams
+	if params != nil {
+		p = *params
+	}
+	return pager.New(func(ctx context.Context, offset string, size int) ([]openapi.OrderFillTransaction, string, error) {
+		p.Size, p.OffsetData = strconv.Itoa(size), offset
+		resp, err := c.GetOrderFillTransactions(ctx, &p)
+		if err != nil {
+			return nil, "", err
+		}
+		data := resp.GetData()
+		return data.GetDataList(), data.GetNextPageOffsetData(), nil
+	}, opts)
+}
+
+// CollectHistoryOrders collects all historical orders created in the window, fetching
+// it in spans of w.Span. The time filters of params are replaced by each span.
+func (c *Client) CollectHistoryOrders(ctx context.Context, params *GetHistoryOrderParams, w pager.Window, opts *pager.Options) ([]openapi.Order, error) {
+	var p GetHistoryOrderParams
+	if params != nil {
+		p = *params
+	}
+	return pager.Collect(ctx, w, opts, func(start, end time.Time, opts *pager.Options) *pager.Pager[openapi.Order] {
+		p.FilterStartCreatedTimeInclusive = uint64(start.UnixMilli())
+		p.FilterEndCreatedTimeExclusive = uint64(end.UnixMilli())
+		return c.HistoryOrdersPager(&p, opts)
+	})
+}
+
+// CollectOrderFillTransactions collects all order fill transactions created in the
+// window, fetching it in spans of w.Span. The time filters of params are replaced
+// by each span.
+func (c *Client) CollectOrderFillTransactions(ctx context.Context, params *OrderFillTransactionParams, w pager.Window, opts *pager.Options) ([]openapi.OrderFillTransaction, error) {
+	var p OrderFillTransactionParams
+	if params != nil {
+		p = *params
+	}
+	return pager.Collect(ctx, w, opts, func(start, end time.Time, opts *pager.Options) *pager.Pager[openapi.OrderFillTransaction] {
+		p.FilterStartCreatedTimeInclusive = uint64(start.UnixMilli())
+		p.FilterEndCreatedTimeExclusive = uint64(end.UnixMilli())
+		return c.OrderFillTransactionsPager(&p, opts)
+	})
+}
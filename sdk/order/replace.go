@@ -0,0 +1,185 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	openapi "github.com/edgex-Tech/edgex-golang-sdk/openapi"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/internal"
+	"github.com/shopspring/decimal"
+)
+
+// ErrOrderFilled is returned by ReplaceOrder when the order was completely filled
+// before it could be canceled, so there is nothing left to re-place
+var ErrOrderFilled = errors.New("order already filled")
+
+// Default cancel confirmation settings of ReplaceOrder
+const (
+	DefaultCancelTimeout      = 5 * time.Second
+	DefaultCancelPollInterval = 200 * time.Millisecond
+)
+
+// CancelConfirmer waits until an order reaches a final status and returns it.
+// It can be backed by the private WebSocket stream instead of polling.
+type CancelConfirmer func(ctx context.Context, orderId string) (*openapi.Order, error)
+
+// ReplaceOrderParams represents parameters for replacing an order
+type ReplaceOrderParams struct {
+	OrderId       string // Order ID to replace
+	ClientOrderId string // Client order ID to replace, used if OrderId is empty
+
+	Price            string  // New price, empty keeps the current price
+	Size             string  // New total size, empty keeps the current size
+	NewClientOrderId *string // Client order ID of the new order, generated if nil
+
+	// WaitForCancel waits until the cancel is confirmed before placing the new order
+	WaitForCancel bool
+	// ConfirmCancel confirms the cancel, nil polls GetOrder
+	ConfirmCancel CancelConfirmer
+	// CancelTimeout bounds the wait for the cancel, 0 uses DefaultCancelTimeout
+	CancelTimeout time.Duration
+}
+
+// ReplaceOrderResult holds the results of both steps of a replace
+type ReplaceOrderResult struct {
+	Cancel        *CancelOrdersResult        // Result of the cancel request
+	CanceledOrder *openapi.Order             // Last known state of the replaced order
+	Order         *openapi.ResultCreateOrder // New order, nil if the replaced order was filled
+}
+
+// ReplaceOrder cancels an order and places a new one with the same contract, side,
+// type and flags at the new price and size, signed with a fresh nonce. The size of
+// the new order is reduced by the size filled before the cancel took effect.
+// Without WaitForCancel the filled size is taken from the order state before the cancel.
+// No new order is placed unless the server reports the cancel as successful.
+func (c *Client) ReplaceOrder(ctx context.Context, params *ReplaceOrderParams, metadata openapi.MetaData) (*ReplaceOrderResult, error) {
+	current, err := c.getSingleOrder(ctx, params.OrderId, params.ClientOrderId)
+	if err != nil {
+		return nil, err
+	}
+	if OrderStatus(current.GetStatus()).IsFinal() {
+		return nil, fmt.Errorf("cannot replace order %s with status %s", current.GetId(), current.GetStatus())
+	}
+
+	result := &ReplaceOrderResult{CanceledOrder: current}
+	result.Cancel, err = c.CancelOrders(ctx, &CancelOrdersParams{OrderIds: []string{current.GetId()}})
+	if err != nil {
+		return result, fmt.Errorf("failed to cancel order %s: %w", current.GetId(), err)
+	}
+	// Placing the new order while the old one may still rest would double the exposure
+	if code := result.Cancel.ByOrderId[current.GetId()]; code != ResponseCodeSuccess {
+		return result, fmt.Errorf("failed to cancel order %s: %w", current.GetId(), &internal.APIError{Code: code})
+	}
+
+	if params.WaitForCancel {
+		confirm := params.ConfirmCancel
+		if confirm == nil {
+			confirm = c.pollOrderFinal
+		}
+		timeout := params.CancelTimeout
+		if timeout <= 0 {
+			timeout = DefaultCancelTimeout
+		}
+		waitCtx, cancel := context.WithTimeout(ctx, timeout)
+		final, err := confirm(waitCtx, current.GetId())
+		cancel()
+		if err != nil {
+			return result, fmt.Errorf("failed to confirm cancel of order %s: %w", current.GetId(), err)
+		}
+		result.CanceledOrder = final
+	}
+
+	newParams, err := replacementParams(result.CanceledOrder, params)
+	if err != nil {
+		return result, err
+	}
+
+	result.Order, err = c.CreateOrder(ctx, newParams, metadata)
+	if err != nil {
+		return result, fmt.Errorf("failed to place replacement order: %w", err)
+	}
+	return result, nil
+}
+
+// replacementParams builds the parameters of the order replacing the given order
+func replacementParams(order *openapi.Order, params *ReplaceOrderParams) (*CreateOrderParams, error) {
+	sizeStr := params.Size
+	if sizeStr == "" {
+		sizeStr = order.GetSize()
+	}
+	size, err := decimal.NewFromString(sizeStr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse size: %w", err)
+	}
+
+	if cumFillSize := order.GetCumFillSize(); cumFillSize != "" {
+		filled, err := decimal.NewFromString(cumFillSize)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cum fill size: %s", cumFillSize)
+		}
+		size = size.Sub(filled)
+	}
+	if !size.IsPositive() {
+		return nil, ErrOrderFilled
+	}
+
+	orderType := OrderType(order.GetType())
+	price := params.Price
+	if price == "" && !(orderType.IsConditional() && orderType.IsMarket()) {
+		// Conditional market orders derive their worst price from the trigger price
+		price = order.GetPrice()
+	}
+
+	return &CreateOrderParams{
+		ContractId:       order.GetContractId(),
+		Price:            price,
+		Size:             size.String(),
+		Type:             orderType,
+		Side:             order.GetSide(),
+		ClientOrderId:    params.NewClientOrderId,
+		TimeInForce:      order.GetTimeInForce(),
+		ReduceOnly:       order.GetReduceOnly(),
+		TriggerPrice:     order.GetTriggerPrice(),
+		TriggerPriceType: TriggerPriceType(order.GetTriggerPriceType()),
+	}, nil
+}
+
+// getSingleOrder gets one order by order ID or client order ID
+func (c *Client) getSingleOrder(ctx context.Context, orderId, clientOrderId string) (*openapi.Order, error) {
+	resp, err := c.GetOrder(ctx, &GetOrderParams{OrderId: orderId, ClientId: clientOrderId})
+	if err != nil {
+		return nil, err
+	}
+	orders := resp.GetData()
+	if len(orders) == 0 {
+		id := orderId
+		if id == "" {
+			id = clientOrderId
+		}
+		return nil, fmt.Errorf("order %s: %w", id, internal.ErrOrderNotFound)
+	}
+	return &orders[0], nil
+}
+
+// pollOrderFinal polls GetOrder until the order reaches a final status
+func (c *Client) pollOrderFinal(ctx context.Context, orderId string) (*openapi.Order, error) {
+	ticker := time.NewTicker(DefaultCancelPollInterval)
+	defer ticker.Stop()
+	for {
+		order, err := c.getSingleOrder(ctx, orderId, "")
+		if err != nil {
+			return nil, err
+		}
+		if OrderStatus(order.GetStatus()).IsFinal() {
+			return order, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return order, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
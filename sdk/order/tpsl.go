@@ -0,0 +1,319 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	openapi "github.com/edgex-Tech/edgex-golang-sdk/openapi"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/internal"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/market"
+	"github.com/shopspring/decimal"
+)
+
+// TpSlParams represents a take-profit or stop-loss leg
+type TpSlParams struct {
+	TriggerPrice     string           // Trigger price, required
+	TriggerPriceType TriggerPriceType // Defaults to LAST_PRICE
+	Price            string           // Limit price, empty means the leg executes at market
+	Size             string           // Leg size, empty means the size of the entry order or position
+	ClientOrderId    *string          // Client order ID of the leg, generated if nil
+}
+
+// BracketOrderParams represents an entry order with attached take-profit and stop-loss legs
+type BracketOrderParams struct {
+	CreateOrderParams             // Entry order
+	TakeProfit        *TpSlParams // Optional take-profit leg
+	StopLoss          *TpSlParams // Optional stop-loss leg
+}
+
+// PositionTpSlParams represents take-profit and stop-loss orders for an existing position
+type PositionTpSlParams struct {
+	ContractId string
+	TakeProfit *TpSlParams // Optional take-profit order
+	StopLoss   *TpSlParams // Optional stop-loss order
+
+	// SkipValidation disables the pre-trade validation of the orders
+	SkipValidation bool
+}
+
+// PositionTpSlResult holds the orders created by CreatePositionTpSl
+type PositionTpSlResult struct {
+	TakeProfit *openapi.ResultCreateOrder // Nil if no take-profit was requested
+	StopLoss   *openapi.ResultCreateOrder // Nil if no stop-loss was requested
+}
+
+// CreateBracketOrder creates an entry order with attached take-profit and stop-loss legs.
+// The legs close the entry order, so they are placed on the opposite side. Each leg is
+// signed as its own L2 limit order, and the entry and its legs are submitted in a single
+// request so they are accepted or rejected together.
+func (c *Client) CreateBracketOrder(ctx context.Context, params *BracketOrderParams, metadata openapi.MetaData) (*openapi.ResultCreateOrder, error) {
+	if params.TakeProfit == nil && params.StopLoss == nil {
+		return nil, fmt.Errorf("bracket order requires a take-profit or stop-loss leg")
+	}
+
+	ctx, createOrderParam, err := c.buildCreateOrderParam(ctx, &params.CreateOrderParams, metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	contract, collateralCoin, err := findContract(metadata, params.ContractId)
+	if err != nil {
+		return nil, err
+	}
+	mkt, err := market.NewMarket(*contract)
+	if err != nil {
+		return nil, err
+	}
+
+	// The entry price may be derived from the trigger price, it is zero for market orders
+	entryPrice, err := decimal.NewFromString(createOrderParam.GetPrice())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse price: %w", err)
+	}
+	legSide := oppositeSide(params.Side)
+
+	// Legs execute when triggered and may take liquidity
+	rates, err := c.resolveFeeRates(ctx, mkt)
+	if err != nil {
+		return nil, err
+	}
+	legFeeRate := feeRate(rates, "")
+
+	// The legs expire together with the entry order
+	var expiry internal.Expiry
+	if expiry.ExpireTime, err = strconv.ParseInt(createOrderParam.GetExpireTime(), 10, 64); err != nil {
+		return nil, fmt.Errorf("failed to parse expire time: %w", err)
+	}
+	if expiry.L2ExpireTime, err = strconv.ParseInt(createOrderParam.GetL2ExpireTime(), 10, 64); err != nil {
+		return nil, fmt.Errorf("failed to parse l2 expire time: %w", err)
+	}
+
+	if params.TakeProfit != nil {
+		if !params.SkipValidation {
+			if err := c.validateLegTrigger(ctx, "TakeProfit", params, params.TakeProfit, mkt, entryPrice, params.Side == OrderSideBuy); err != nil {
+				return nil, err
+			}
+		}
+		openTp, err := c.buildTpSlParam("TakeProfit", params.TakeProfit, contract, collateralCoin, mkt, legSide, params.Size, legFeeRate, expiry, !params.SkipValidation)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build take-profit leg: %w", err)
+		}
+		createOrderParam.SetIsSetOpenTp(true)
+		createOrderParam.SetOpenTp(*openTp)
+	}
+
+	if params.StopLoss != nil {
+		if !params.SkipValidation {
+			if err := c.validateLegTrigger(ctx, "StopLoss", params, params.StopLoss, mkt, entryPrice, params.Side != OrderSideBuy); err != nil {
+				return nil, err
+			}
+		}
+		openSl, err := c.buildTpSlParam("StopLoss", params.StopLoss, contract, collateralCoin, mkt, legSide, params.Size, legFeeRate, expiry, !params.SkipValidation)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build stop-loss leg: %w", err)
+		}
+		createOrderParam.SetIsSetOpenSl(true)
+		createOrderParam.SetOpenSl(*openSl)
+	}
+
+	return c.submitOrder(ctx, createOrderParam)
+}
+
+// CreatePositionTpSl places take-profit and stop-loss orders for the whole open position
+// in a contract. The orders are reduce-only conditional orders on the side closing the
+// position, sized to the position unless a leg size is given.
+func (c *Client) CreatePositionTpSl(ctx context.Context, params *PositionTpSlParams, metadata openapi.MetaData) (*PositionTpSlResult, error) {
+	if params.TakeProfit == nil && params.StopLoss == nil {
+		return nil, fmt.Errorf("position tp/sl requires a take-profit or stop-loss order")
+	}
+
+	positionSize, err := c.getPositionSize(ctx, params.ContractId)
+	if err != nil {
+		return nil, err
+	}
+	if positionSize.IsZero() {
+		return nil, fmt.Errorf("no open position in contract %s", params.ContractId)
+	}
+
+	side := OrderSideSell
+	if positionSize.IsNegative() {
+		side = OrderSideBuy
+	}
+
+	result := &PositionTpSlResult{}
+	if params.TakeProfit != nil {
+		orderParams := positionTpSlOrder(params, params.TakeProfit, side, positionSize.Abs(), OrderTypeTakeProfitMarket, OrderTypeTakeProfitLimit)
+		result.TakeProfit, err = c.CreateOrder(ctx, orderParams, metadata)
+		if err != nil {
+			return result, fmt.Errorf("failed to create take-profit order: %w", err)
+		}
+	}
+	if params.StopLoss != nil {
+		orderParams := positionTpSlOrder(params, params.StopLoss, side, positionSize.Abs(), OrderTypeStopMarket, OrderTypeStopLimit)
+		result.StopLoss, err = c.CreateOrder(ctx, orderParams, metadata)
+		if err != nil {
+			return result, fmt.Errorf("failed to create stop-loss order: %w", err)
+		}
+	}
+	return result, nil
+}
+
+// positionTpSlOrder converts a position take-profit/stop-loss leg into order parameters
+func positionTpSlOrder(params *PositionTpSlParams, leg *TpSlParams, side string, positionSize decimal.Decimal, marketType, limitType OrderType) *CreateOrderParams {
+	size := leg.Size
+	if size == "" {
+		size = positionSize.String()
+	}
+	orderType := marketType
+	if leg.Price != "" {
+		orderType = limitType
+	}
+	return &CreateOrderParams{
+		ContractId:       params.ContractId,
+		Price:            leg.Price,
+		Size:             size,
+		Type:             orderType,
+		Side:             side,
+		ClientOrderId:    leg.ClientOrderId,
+		ReduceOnly:       true,
+		TriggerPrice:     leg.TriggerPrice,
+		TriggerPriceType: leg.TriggerPriceType,
+		IsPositionTpsl:   true,
+		SkipValidation:   params.SkipValidation,
+	}
+}
+
+// buildTpSlParam signs a take-profit or stop-loss leg of a bracket order. With validate
+// the leg is checked against the market rules and may not exceed the entry size.
+func (c *Client) buildTpSlParam(field string, leg *TpSlParams, contract *openapi.Contract, collateralCoin *openapi.Coin, mkt *market.Market, side, entrySize string, feeRate decimal.Decimal, expiry internal.Expiry, validate bool) (*openapi.OpenTpSlParam, error) {
+	if leg.TriggerPrice == "" {
+		return nil, fmt.Errorf("trigger price is required")
+	}
+	triggerPrice, err := decimal.NewFromString(leg.TriggerPrice)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse trigger price: %w", err)
+	}
+	triggerPriceType := leg.TriggerPriceType
+	if triggerPriceType == "" {
+		triggerPriceType = TriggerPriceTypeLast
+	}
+
+	sizeStr := leg.Size
+	if sizeStr == "" {
+		sizeStr = entrySize
+	}
+	size, err := decimal.NewFromString(sizeStr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse size: %w", err)
+	}
+
+	// Market legs are sent with price 0 and signed with the worst price
+	priceStr := leg.Price
+	var price decimal.Decimal
+	if priceStr == "" {
+		price = worstPrice(mkt, triggerPrice, side)
+		priceStr = "0"
+	} else {
+		price, err = decimal.NewFromString(priceStr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse price: %w", err)
+		}
+	}
+
+	if validate {
+		if err := validateLegOrder(field, mkt, price, size, entrySize); err != nil {
+			return nil, err
+		}
+	}
+
+	clientOrderId := internal.GenerateUUID()
+	if leg.ClientOrderId != nil {
+		clientOrderId = *leg.ClientOrderId
+	}
+
+	signed, err := c.signLimitOrder(contract, collateralCoin, mkt, side, price, size, feeRate, clientOrderId, expiry)
+	if err != nil {
+		return nil, err
+	}
+
+	triggerPriceTypeStr := string(triggerPriceType)
+	return &openapi.OpenTpSlParam{
+		Side:             &side,
+		Price:            &priceStr,
+		Size:             &sizeStr,
+		ClientOrderId:    &clientOrderId,
+		TriggerPrice:     &leg.TriggerPrice,
+		TriggerPriceType: &triggerPriceTypeStr,
+		ExpireTime:       &signed.ExpireTime,
+		L2Nonce:          &signed.Nonce,
+		L2Value:          &signed.Value,
+		L2Size:           &sizeStr,
+		L2LimitFee:       &signed.LimitFee,
+		L2ExpireTime:     &signed.L2ExpireTime,
+		L2Signature:      &signed.Signature,
+	}, nil
+}
+
+// validateLegTrigger checks that the trigger price of a leg is on the tick size and on
+// the expected side of the entry price. Market entries have no entry price, their legs
+// are checked against the current price of the leg trigger type instead.
+func (c *Client) validateLegTrigger(ctx context.Context, field string, params *BracketOrderParams, leg *TpSlParams, mkt *market.Market, entryPrice decimal.Decimal, above bool) error {
+	field += ".TriggerPrice"
+	triggerPrice, err := decimal.NewFromString(leg.TriggerPrice)
+	if err != nil || !triggerPrice.IsPositive() {
+		return &ValidationError{Field: field, Value: leg.TriggerPrice, Reason: "must be a positive number"}
+	}
+	if mkt.TickSize.IsPositive() && !triggerPrice.Mod(mkt.TickSize).IsZero() {
+		return &ValidationError{Field: field, Value: leg.TriggerPrice, Reason: fmt.Sprintf("not a multiple of tick size %s", mkt.TickSize)}
+	}
+
+	reference := "entry price"
+	if params.Type.IsMarket() {
+		priceType := leg.TriggerPriceType
+		if priceType == "" {
+			priceType = TriggerPriceTypeLast
+		}
+		entryPrice, err = c.getTriggerPrice(ctx, params.ContractId, priceType)
+		if err != nil {
+			return err
+		}
+		if !entryPrice.IsPositive() {
+			return nil
+		}
+		reference = string(priceType)
+	}
+
+	if above && !triggerPrice.GreaterThan(entryPrice) {
+		return &ValidationError{Field: field, Value: leg.TriggerPrice, Reason: fmt.Sprintf("must be above %s %s", reference, entryPrice)}
+	}
+	if !above && !triggerPrice.LessThan(entryPrice) {
+		return &ValidationError{Field: field, Value: leg.TriggerPrice, Reason: fmt.Sprintf("must be below %s %s", reference, entryPrice)}
+	}
+	return nil
+}
+
+// validateLegOrder checks the signed price and size of a leg against the market rules
+// and the size of the entry order
+func validateLegOrder(field string, mkt *market.Market, price, size decimal.Decimal, entrySize string) error {
+	if err := mkt.ValidateOrder(price, size); err != nil {
+		var validationErr *ValidationError
+		if errors.As(err, &validationErr) {
+			validationErr.Field = field + "." + validationErr.Field
+		}
+		return err
+	}
+	if entry, err := decimal.NewFromString(entrySize); err == nil && size.GreaterThan(entry) {
+		return &ValidationError{Field: field + ".Size", Value: size.String(), Reason: fmt.Sprintf("exceeds entry size %s", entry)}
+	}
+	return nil
+}
+
+// oppositeSide returns the side closing an order on the given side
+func oppositeSide(side string) string {
+	if side == OrderSideBuy {
+		return OrderSideSell
+	}
+	return OrderSideBuy
+}
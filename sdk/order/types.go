@@ -1,5 +1,7 @@
 package order
 
+import "time"
+
 // TimeInForce constants
 type TimeInForce string
 
@@ -35,6 +37,47 @@ const (
 	OrderTypeTakeProfitMarket OrderType = "TAKE_PROFIT_MARKET"
 )
 
+// IsMarket reports whether the order type executes at market price
+func (t OrderType) IsMarket() bool {
+	return t == OrderTypeMarket || t == OrderTypeStopMarket || t == OrderTypeTakeProfitMarket
+}
+
+// IsConditional reports whether the order type is triggered by a trigger price
+func (t OrderType) IsConditional() bool {
+	return t == OrderTypeStopLimit || t == OrderTypeStopMarket || t == OrderTypeTakeProfitLimit || t == OrderTypeTakeProfitMarket
+}
+
+// IsStop reports whether the order type is a stop order
+func (t OrderType) IsStop() bool {
+	return t == OrderTypeStopLimit || t == OrderTypeStopMarket
+}
+
+// OrderStatus represents the status of an order
+type OrderStatus string
+
+const (
+	OrderStatusPending     OrderStatus = "PENDING"
+	OrderStatusOpen        OrderStatus = "OPEN"
+	OrderStatusFilled      OrderStatus = "FILLED"
+	OrderStatusCanceling   OrderStatus = "CANCELING"
+	OrderStatusCanceled    OrderStatus = "CANCELED"
+	OrderStatusUntriggered OrderStatus = "UNTRIGGERED"
+)
+
+// IsFinal reports whether the order can no longer change
+func (s OrderStatus) IsFinal() bool {
+	return s == OrderStatusFilled || s == OrderStatusCanceled
+}
+
+// TriggerPriceType represents the price a conditional order is triggered on
+type TriggerPriceType string
+
+const (
+	TriggerPriceTypeLast   TriggerPriceType = "LAST_PRICE"   // Last traded price
+	TriggerPriceTypeOracle TriggerPriceType = "ORACLE_PRICE" // Oracle (mark) price
+	TriggerPriceTypeIndex  TriggerPriceType = "INDEX_PRICE"  // Index price
+)
+
 // Common filter types used across different order APIs
 type OrderFilterParams struct {
 	FilterCoinIdList     []string // Filter by coin IDs, empty means all coins
@@ -91,9 +134,30 @@ type CreateOrderParams struct {
 	Type          OrderType `json:"type"`
 	Side          string    `json:"side"`
 	ClientOrderId *string   `json:"clientOrderId,omitempty"`
-	L2ExpireTime  *int64    `json:"l2ExpireTime,omitempty"`
+	L2ExpireTime  *int64    `json:"l2ExpireTime,omitempty"` // L2 signature expire time in unix milliseconds, takes precedence over ExpireTime and ExpireAfter
 	TimeInForce   string    `json:"timeInForce,omitempty"`
 	ReduceOnly    bool      `json:"reduceOnly,omitempty"`
+
+	// Order expiry for good-til-time orders, either absolute or relative to now.
+	// The L2 signature expires 10 days after the order. When neither is set the
+	// L2 signature expires after 14 days.
+	ExpireTime  *time.Time    `json:"-"`
+	ExpireAfter time.Duration `json:"-"`
+
+	// Conditional order parameters, required for STOP_* and TAKE_PROFIT_* order types.
+	// For STOP_MARKET and TAKE_PROFIT_MARKET orders without a price, the worst
+	// execution price used for signing is derived from the trigger price.
+	TriggerPrice     string           `json:"triggerPrice,omitempty"`
+	TriggerPriceType TriggerPriceType `json:"triggerPriceType,omitempty"` // Defaults to LAST_PRICE
+
+	// IsPositionTpsl marks a conditional order as a take-profit/stop-loss of the whole position
+	IsPositionTpsl bool `json:"isPositionTpsl,omitempty"`
+
+	// SkipValidation disables the pre-trade validation against contract limits,
+	// price bands and the current position. The validation fetches the ticker of the
+	// contract when the cached one is stale, see Client.UpdateTicker, and the account
+	// positions for reduce-only orders.
+	SkipValidation bool `json:"-"`
 }
 
 // CancelOrderParams represents parameters for canceling orders
@@ -0,0 +1,247 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	openapi "github.com/edgex-Tech/edgex-golang-sdk/openapi"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/internal"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/market"
+	"github.com/shopspring/decimal"
+)
+
+// ValidationError describes an order field rejected by the pre-trade validation
+type ValidationError = market.ValidationError
+
+// DefaultPriceMaxAge is how long a ticker price is used by the pre-trade validation
+// before it is fetched again
+const DefaultPriceMaxAge = 2 * time.Second
+
+// priceCache caches the tickers used to check price bands and trigger prices
+type priceCache struct {
+	mu      sync.RWMutex
+	maxAge  time.Duration
+	tickers map[string]cachedTicker
+}
+
+type cachedTicker struct {
+	ticker    openapi.Ticker
+	updatedAt time.Time
+}
+
+// SetPriceMaxAge sets how long a ticker price is used by the pre-trade validation
+// before it is fetched again, 0 uses DefaultPriceMaxAge
+func (c *Client) SetPriceMaxAge(maxAge time.Duration) {
+	c.prices.mu.Lock()
+	defer c.prices.mu.Unlock()
+	c.prices.maxAge = maxAge
+}
+
+// UpdateTicker feeds a ticker to the price cache of the pre-trade validation. Feeding
+// the tickers of the public WebSocket keeps the validation from fetching prices.
+func (c *Client) UpdateTicker(ticker openapi.Ticker) {
+	c.prices.mu.Lock()
+	defer c.prices.mu.Unlock()
+	if c.prices.tickers == nil {
+		c.prices.tickers = make(map[string]cachedTicker)
+	}
+	c.prices.tickers[ticker.GetContractId()] = cachedTicker{ticker: ticker, updatedAt: time.Now()}
+}
+
+// validateOrder runs the pre-trade checks of an order against the market rules,
+// the oracle price bands and the current position. Limit and conditional orders need
+// the ticker of the contract, which is fetched when the cached one is older than the
+// price max age. Reduce-only orders and contracts closed for opening fetch the
+// account positions.
+func (c *Client) validateOrder(ctx context.Context, params *CreateOrderParams, mkt *market.Market, price, size decimal.Decimal) error {
+	if !mkt.EnableTrade {
+		return &ValidationError{Field: "ContractId", Value: params.ContractId, Reason: "trading is disabled"}
+	}
+
+	if err := mkt.ValidateOrder(price, size); err != nil {
+		return err
+	}
+
+	// Market orders carry a worst price, only limit prices are checked against the bands.
+	// Conditional orders are checked against the current price of their trigger type instead.
+	if params.Type.IsConditional() {
+		if err := c.validateTrigger(ctx, params, mkt); err != nil {
+			return err
+		}
+	} else if !params.Type.IsMarket() && (mkt.MaxOrderBuyPriceRatio.IsPositive() || mkt.MinOrderSellPriceRatio.IsPositive()) {
+		oraclePrice, err := c.getOraclePrice(ctx, params.ContractId)
+		if err != nil {
+			return err
+		}
+		if err := mkt.ValidatePriceBand(price, oraclePrice, params.Side); err != nil {
+			return err
+		}
+	}
+
+	if params.ReduceOnly || !mkt.EnableOpenPosition {
+		positionSize, err := c.getPositionSize(ctx, params.ContractId)
+		if err != nil {
+			return err
+		}
+		if err := validateReduceOnly(params, mkt, size, positionSize); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateTrigger checks the trigger price of a conditional order against the tick
+// size and the current price of its trigger type
+func (c *Client) validateTrigger(ctx context.Context, params *CreateOrderParams, mkt *market.Market) error {
+	triggerPrice, err := decimal.NewFromString(params.TriggerPrice)
+	if err != nil || !triggerPrice.IsPositive() {
+		return &ValidationError{Field: "TriggerPrice", Value: params.TriggerPrice, Reason: "must be a positive number"}
+	}
+	if mkt.TickSize.IsPositive() && !triggerPrice.Mod(mkt.TickSize).IsZero() {
+		return &ValidationError{Field: "TriggerPrice", Value: params.TriggerPrice, Reason: fmt.Sprintf("not a multiple of tick size %s", mkt.TickSize)}
+	}
+
+	currentPrice, err := c.getTriggerPrice(ctx, params.ContractId, params.TriggerPriceType)
+	if err != nil {
+		return err
+	}
+	return validateTriggerDirection(params, triggerPrice, currentPrice)
+}
+
+// validateTriggerDirection checks that a conditional order is not triggered immediately.
+// Stop orders trigger when the price moves against the position: buy stops above and
+// sell stops below the current price. Take-profit orders trigger the other way round.
+func validateTriggerDirection(params *CreateOrderParams, triggerPrice, currentPrice decimal.Decimal) error {
+	if !currentPrice.IsPositive() {
+		return nil
+	}
+
+	above := params.Side == OrderSideBuy
+	if !params.Type.IsStop() {
+		above = !above
+	}
+	if above && !triggerPrice.GreaterThan(currentPrice) {
+		return &ValidationError{Field: "TriggerPrice", Value: triggerPrice.String(), Reason: fmt.Sprintf("%s %s must trigger above %s %s", params.Side, params.Type, params.TriggerPriceType, currentPrice)}
+	}
+	if !above && !triggerPrice.LessThan(currentPrice) {
+		return &ValidationError{Field: "TriggerPrice", Value: triggerPrice.String(), Reason: fmt.Sprintf("%s %s must trigger below %s %s", params.Side, params.Type, params.TriggerPriceType, currentPrice)}
+	}
+	return nil
+}
+
+// validateReduceOnly checks that an order only reduces the current position.
+// A positive position size is long and a negative one is short.
+func validateReduceOnly(params *CreateOrderParams, mkt *market.Market, size, positionSize decimal.Decimal) error {
+	field := "ReduceOnly"
+	if !params.ReduceOnly {
+		field = "ContractId"
+	}
+
+	reduces := (params.Side == OrderSideBuy && positionSize.IsNegative()) ||
+		(params.Side == OrderSideSell && positionSize.IsPositive())
+	if !reduces {
+		if !params.ReduceOnly {
+			return &ValidationError{Field: field, Value: params.ContractId, Reason: "opening positions is disabled"}
+		}
+		return &ValidationError{Field: field, Value: "true", Reason: fmt.Sprintf("%s order does not reduce position %s", params.Side, positionSize)}
+	}
+	if size.GreaterThan(positionSize.Abs()) {
+		return &ValidationError{Field: "Size", Value: size.String(), Reason: fmt.Sprintf("exceeds position size %s of contract %s", positionSize.Abs(), mkt.ContractId)}
+	}
+	return nil
+}
+
+// getOraclePrice gets the current oracle price of a contract
+func (c *Client) getOraclePrice(ctx context.Context, contractID string) (decimal.Decimal, error) {
+	return c.getTriggerPrice(ctx, contractID, TriggerPriceTypeOracle)
+}
+
+// getTriggerPrice gets the current price of a contract for the given trigger price type
+func (c *Client) getTriggerPrice(ctx context.Context, contractID string, priceType TriggerPriceType) (decimal.Decimal, error) {
+	ticker, err := c.getTicker(ctx, contractID)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	var value string
+	switch priceType {
+	case TriggerPriceTypeOracle:
+		value = ticker.GetOraclePrice()
+	case TriggerPriceTypeIndex:
+		value = ticker.GetIndexPrice()
+	case TriggerPriceTypeLast:
+		value = ticker.GetLastPrice()
+	default:
+		return decimal.Zero, &ValidationError{Field: "TriggerPriceType", Value: string(priceType), Reason: "unsupported trigger price type"}
+	}
+	currentPrice, err := decimal.NewFromString(value)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("invalid %s: %s", priceType, value)
+	}
+	return currentPrice, nil
+}
+
+// getTicker gets the ticker of a contract from the price cache, fetching it when the
+// cached one is older than the price max age
+func (c *Client) getTicker(ctx context.Context, contractID string) (*openapi.Ticker, error) {
+	c.prices.mu.RLock()
+	cached, ok := c.prices.tickers[contractID]
+	maxAge := c.prices.maxAge
+	c.prices.mu.RUnlock()
+	if maxAge <= 0 {
+		maxAge = DefaultPriceMaxAge
+	}
+	if ok && time.Since(cached.updatedAt) < maxAge {
+		return &cached.ticker, nil
+	}
+
+	resp, httpResp, err := c.openapiClient.Class01QuotePublicApiAPI.GetTicker(ctx).
+		ContractId(contractID).
+		Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ticker: %w", internal.ParseError(err, httpResp))
+	}
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
+	}
+
+	tickers := resp.GetData()
+	if len(tickers) == 0 {
+		return nil, fmt.Errorf("ticker not found: %s", contractID)
+	}
+	ticker := tickers[0]
+	if ticker.GetContractId() == "" {
+		ticker.SetContractId(contractID)
+	}
+	c.UpdateTicker(ticker)
+	return &ticker, nil
+}
+
+// getPositionSize gets the signed open size of the account position in a contract
+func (c *Client) getPositionSize(ctx context.Context, contractID string) (decimal.Decimal, error) {
+	resp, httpResp, err := c.openapiClient.Class03AccountPrivateApiAPI.GetAccountAsset(ctx).
+		AccountId(fmt.Sprintf("%d", c.GetAccountID())).
+		Execute()
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("failed to get account positions: %w", internal.ParseError(err, httpResp))
+	}
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return decimal.Zero, err
+	}
+
+	data := resp.GetData()
+	for _, position := range data.GetPositionList() {
+		if position.GetContractId() != contractID {
+			continue
+		}
+		openSize, err := decimal.NewFromString(position.GetOpenSize())
+		if err != nil {
+			return decimal.Zero, fmt.Errorf("invalid position open size: %s", position.GetOpenSize())
+		}
+		return openSize, nil
+	}
+	return decimal.Zero, nil
+}
@@ -0,0 +1,486 @@
+// Package orderbook maintains a local L2 order book from the depth channel of the
+// public WebSocket. Deltas are applied in version order and the book resyncs from
+// the REST depth endpoint in the background when a version gap is detected.
+package orderbook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	openapi "github.com/edgex-Tech/edgex-golang-sdk/openapi"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/quote"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/ws"
+	"github.com/shopspring/decimal"
+)
+
+// DefaultLevels is the number of levels of the depth channel
+const DefaultLevels = 15
+
+// Defaults of the delay between failed resyncs
+const (
+	DefaultResyncBackoff    = 500 * time.Millisecond
+	DefaultMaxResyncBackoff = 30 * time.Second
+)
+
+// maxBufferedDeltas bounds the deltas held back while a resync is in flight
+const maxBufferedDeltas = 1024
+
+// ErrNotSynced is returned by reads of a book that has not received a snapshot yet
+var ErrNotSynced = errors.New("order book not synced")
+
+// Side is a side of the book
+type Side string
+
+const (
+	SideBid Side = "BID"
+	SideAsk Side = "ASK"
+)
+
+// Level is a price level of the book
+type Level struct {
+	Price decimal.Decimal
+	Size  decimal.Decimal
+}
+
+// DepthFetcher fetches a depth snapshot, implemented by quote.Client and sdk.Client
+type DepthFetcher interface {
+	GetOrderBookDepth(ctx context.Context, params quote.GetOrderBookDepthParams) (*openapi.ResultListDepth, error)
+}
+
+// Options configures a Book
+type Options struct {
+	Levels           int32               // Levels of the resync snapshot, 0 uses DefaultLevels
+	ResyncBackoff    time.Duration       // Delay before retrying a failed resync, doubled after each failure, 0 uses DefaultResyncBackoff
+	MaxResyncBackoff time.Duration       // Upper bound of the resync delay, 0 uses DefaultMaxResyncBackoff
+	OnError          func(err error)     // Called when an event fails to apply or a background resync fails
+	OnSync           func(version int64) // Called after the book is synced from a snapshot with its version
+}
+
+// Book is a local L2 order book of a contract. It is safe for concurrent use.
+type Book struct {
+	contractID string
+	fetcher    DepthFetcher
+	opts       Options
+
+	applyMu   sync.Mutex      // Serializes updates, never held across a fetch
+	resyncing bool            // A background resync is in flight
+	failures  int             // Failed resyncs in a row
+	buffered  []openapi.Depth // Deltas received while resyncing, oldest first
+
+	mu      sync.RWMutex
+	bids    []Level // Sorted by price descending
+	asks    []Level // Sorted by price ascending
+	version int64
+	synced  bool
+}
+
+// New creates an empty book of a contract that resyncs through fetcher
+func New(contractID string, fetcher DepthFetcher, opts *Options) *Book {
+	b := &Book{contractID: contractID, fetcher: fetcher}
+	if opts != nil {
+		b.opts = *opts
+	}
+	if b.opts.Levels <= 0 {
+		b.opts.Levels = DefaultLevels
+	}
+	if b.opts.ResyncBackoff <= 0 {
+		b.opts.ResyncBackoff = DefaultResyncBackoff
+	}
+	if b.opts.MaxResyncBackoff <= 0 {
+		b.opts.MaxResyncBackoff = DefaultMaxResyncBackoff
+	}
+	return b
+}
+
+// Subscribe subscribes the book to the depth channel of its contract. Canceling ctx
+// stops the resyncs triggered by the subscription, unsubscribing detaches the book.
+func (b *Book) Subscribe(ctx context.Context, manager *ws.Manager) (*ws.Subscription, error) {
+	return manager.SubscribeDepthEvents(b.contractID, b.Handler(ctx))
+}
+
+// Handler returns a depth event handler applying events to the book. Errors are
+// reported to Options.OnError.
+func (b *Book) Handler(ctx context.Context) func(ws.DepthEvent) {
+	return func(event ws.DepthEvent) {
+		for _, depth := range event.Data {
+			if err := b.Apply(ctx, event.DataType, depth); err != nil && b.opts.OnError != nil {
+				b.opts.OnError(err)
+			}
+		}
+	}
+}
+
+// Apply applies a depth update. A snapshot replaces the book. A change is applied if
+// it continues the current version and is dropped if already applied. If versions
+// were missed the book starts a resync from the REST depth endpoint in the background
+// and holds back later changes, which are applied on top of the fetched snapshot.
+// Apply never waits for the network, so it can be called from the WebSocket read
+// loop. ctx bounds the background resync.
+func (b *Book) Apply(ctx context.Context, dataType ws.DataType, depth openapi.Depth) error {
+	if depth.GetContractId() != "" && depth.GetContractId() != b.contractID {
+		return fmt.Errorf("depth of contract %s applied to order book of %s", depth.GetContractId(), b.contractID)
+	}
+	startVersion, endVersion, err := versions(depth)
+	if err != nil {
+		return err
+	}
+
+	b.applyMu.Lock()
+	defer b.applyMu.Unlock()
+
+	if dataType == ws.DataTypeSnapshot || strings.EqualFold(depth.GetDepthType(), "SNAPSHOT") {
+		// A resync in flight discards its snapshot once it sees the book synced
+		b.replace(depth, endVersion)
+		b.buffered = nil
+		b.notifySync(endVersion)
+		return nil
+	}
+
+	b.mu.RLock()
+	synced, version := b.synced, b.version
+	b.mu.RUnlock()
+
+	switch {
+	case synced && endVersion <= version:
+		// Already contained in the book
+		return nil
+	case synced && startVersion <= version+1:
+		b.applyChange(depth, endVersion)
+		return nil
+	default:
+		b.markUnsynced()
+		if len(b.buffered) == maxBufferedDeltas {
+			b.buffered = b.buffered[1:]
+		}
+		b.buffered = append(b.buffered, depth)
+		if !b.resyncing {
+			b.resyncing = true
+			go b.resyncLoop(ctx)
+		}
+		return nil
+	}
+}
+
+// Resync replaces the book with a snapshot from the REST depth endpoint and applies
+// the changes held back since a gap on top of it
+func (b *Book) Resync(ctx context.Context) error {
+	snapshot, err := b.fetch(ctx)
+	if err != nil {
+		return err
+	}
+	b.applyMu.Lock()
+	defer b.applyMu.Unlock()
+	return b.install(snapshot)
+}
+
+// resyncLoop fetches snapshots until one bridges the held back changes, the book is
+// synced by a WebSocket snapshot or ctx is canceled. Failed attempts back off.
+func (b *Book) resyncLoop(ctx context.Context) {
+	for {
+		b.applyMu.Lock()
+		failures := b.failures
+		b.applyMu.Unlock()
+
+		if failures > 0 {
+			timer := time.NewTimer(b.backoff(failures))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				b.applyMu.Lock()
+				b.resyncing = false
+				b.applyMu.Unlock()
+				return
+			case <-timer.C:
+			}
+		}
+
+		snapshot, err := b.fetch(ctx)
+
+		b.applyMu.Lock()
+		if err == nil {
+			err = b.install(snapshot)
+		}
+		if err == nil || b.Synced() || ctx.Err() != nil {
+			b.resyncing = false
+			b.applyMu.Unlock()
+			if err != nil && ctx.Err() == nil {
+				b.reportError(err)
+			}
+			return
+		}
+		b.failures++
+		b.applyMu.Unlock()
+		b.reportError(err)
+	}
+}
+
+// backoff returns the delay before the next resync after failures failed attempts
+func (b *Book) backoff(failures int) time.Duration {
+	delay := b.opts.ResyncBackoff
+	for i := 1; i < failures; i++ {
+		delay *= 2
+		if delay >= b.opts.MaxResyncBackoff {
+			return b.opts.MaxResyncBackoff
+		}
+	}
+	return delay
+}
+
+// fetch fetches a depth snapshot from the REST depth endpoint
+func (b *Book) fetch(ctx context.Context) (openapi.Depth, error) {
+	resp, err := b.fetcher.GetOrderBookDepth(ctx, quote.GetOrderBookDepthParams{ContractID: b.contractID, Size: b.opts.Levels})
+	if err != nil {
+		return openapi.Depth{}, fmt.Errorf("failed to resync order book: %w", err)
+	}
+	depths := resp.GetData()
+	if len(depths) == 0 {
+		return openapi.Depth{}, fmt.Errorf("failed to resync order book: empty depth of contract %s", b.contractID)
+	}
+	return depths[0], nil
+}
+
+// install replaces the book with a fetched snapshot and applies the held back changes
+// newer than it. A snapshot that does not reach the held back changes leaves the book
+// unsynced. b.applyMu must be held.
+func (b *Book) install(snapshot openapi.Depth) error {
+	if b.Synced() {
+		// A WebSocket snapshot arrived in the meantime
+		b.failures = 0
+		b.buffered = nil
+		return nil
+	}
+	_, version, err := versions(snapshot)
+	if err != nil {
+		return err
+	}
+
+	// Keep the changes newer than the snapshot, they must continue it without a gap
+	var changes []openapi.Depth
+	next := version
+	for _, depth := range b.buffered {
+		startVersion, endVersion, _ := versions(depth)
+		if endVersion <= next {
+			continue
+		}
+		if startVersion > next+1 {
+			return fmt.Errorf("failed to resync order book: snapshot version %d is behind held back version %d", next, startVersion)
+		}
+		changes = append(changes, depth)
+		next = endVersion
+	}
+
+	b.replace(snapshot, version)
+	for _, depth := range changes {
+		_, endVersion, _ := versions(depth)
+		b.applyChange(depth, endVersion)
+	}
+	b.failures = 0
+	b.buffered = nil
+	b.notifySync(next)
+	return nil
+}
+
+// applyChange applies the levels of a change, b.applyMu must be held
+func (b *Book) applyChange(depth openapi.Depth, version int64) {
+	// Levels carry absolute sizes, so overlapping updates apply cleanly
+	b.mu.Lock()
+	b.bids = applyLevels(b.bids, depth.GetBids(), SideBid)
+	b.asks = applyLevels(b.asks, depth.GetAsks(), SideAsk)
+	b.version = version
+	b.mu.Unlock()
+}
+
+// markUnsynced marks the book as missing updates, b.applyMu must be held
+func (b *Book) markUnsynced() {
+	b.mu.Lock()
+	b.synced = false
+	b.mu.Unlock()
+}
+
+// reportError reports an error of a background resync to Options.OnError
+func (b *Book) reportError(err error) {
+	if b.opts.OnError != nil {
+		b.opts.OnError(err)
+	}
+}
+
+// replace replaces the book with a snapshot, b.applyMu must be held
+func (b *Book) replace(depth openapi.Depth, version int64) {
+	b.mu.Lock()
+	b.bids = applyLevels(nil, depth.GetBids(), SideBid)
+	b.asks = applyLevels(nil, depth.GetAsks(), SideAsk)
+	b.version = version
+	b.synced = true
+	b.mu.Unlock()
+}
+
+// notifySync calls Options.OnSync after the book was replaced by a snapshot
+func (b *Book) notifySync(version int64) {
+	if b.opts.OnSync != nil {
+		b.opts.OnSync(version)
+	}
+}
+
+// Version returns the version of the last applied update
+func (b *Book) Version() int64 {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.version
+}
+
+// Synced reports whether the book holds a snapshot and all later updates
+func (b *Book) Synced() bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.synced
+}
+
+// BestBid returns the highest bid
+func (b *Book) BestBid() (Level, error) {
+	return b.best(SideBid)
+}
+
+// BestAsk returns the lowest ask
+func (b *Book) BestAsk() (Level, error) {
+	return b.best(SideAsk)
+}
+
+func (b *Book) best(side Side) (Level, error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if !b.synced {
+		return Level{}, ErrNotSynced
+	}
+	levels := b.levels(side)
+	if len(levels) == 0 {
+		return Level{}, fmt.Errorf("no %s levels in order book of %s", strings.ToLower(string(side)), b.contractID)
+	}
+	return levels[0], nil
+}
+
+// Levels returns up to n levels of a side from the best price, n <= 0 returns all
+func (b *Book) Levels(side Side, n int) ([]Level, error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if !b.synced {
+		return nil, ErrNotSynced
+	}
+	levels := b.levels(side)
+	if n <= 0 || n > len(levels) {
+		n = len(levels)
+	}
+	return append([]Level(nil), levels[:n]...), nil
+}
+
+// SizeAt returns the size resting at price on a side, zero if there is no level
+func (b *Book) SizeAt(side Side, price decimal.Decimal) (decimal.Decimal, error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if !b.synced {
+		return decimal.Zero, ErrNotSynced
+	}
+	levels := b.levels(side)
+	if i, found := search(levels, price, side); found {
+		return levels[i].Size, nil
+	}
+	return decimal.Zero, nil
+}
+
+// SizeForNotional returns the size that fills notional walking a side from the best
+// price, and the average fill price. ok is false if the side holds less than notional,
+// in which case the size and average price of the whole side are returned.
+func (b *Book) SizeForNotional(side Side, notional decimal.Decimal) (size, avgPrice decimal.Decimal, ok bool, err error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if !b.synced {
+		return decimal.Zero, decimal.Zero, false, ErrNotSynced
+	}
+	if !notional.IsPositive() {
+		return decimal.Zero, decimal.Zero, true, nil
+	}
+
+	filled := decimal.Zero
+	for _, level := range b.levels(side) {
+		levelNotional := level.Price.Mul(level.Size)
+		if filled.Add(levelNotional).GreaterThanOrEqual(notional) {
+			size = size.Add(notional.Sub(filled).Div(level.Price))
+			return size, notional.Div(size), true, nil
+		}
+		filled = filled.Add(levelNotional)
+		size = size.Add(level.Size)
+	}
+	if size.IsZero() {
+		return size, decimal.Zero, false, nil
+	}
+	return size, filled.Div(size), false, nil
+}
+
+// levels returns the levels of a side, b.mu must be held
+func (b *Book) levels(side Side) []Level {
+	if side == SideBid {
+		return b.bids
+	}
+	return b.asks
+}
+
+// applyLevels sets the sizes of updates on the sorted levels of a side, removing
+// levels with zero size
+func applyLevels(levels []Level, updates []openapi.BookOrder, side Side) []Level {
+	for _, update := range updates {
+		price, err := decimal.NewFromString(update.GetPrice())
+		if err != nil {
+			continue
+		}
+		size, err := decimal.NewFromString(update.GetSize())
+		if err != nil {
+			continue
+		}
+
+		i, found := search(levels, price, side)
+		switch {
+		case found && size.IsZero():
+			levels = append(levels[:i], levels[i+1:]...)
+		case found:
+			levels[i].Size = size
+		case !size.IsZero():
+			levels = append(levels, Level{})
+			copy(levels[i+1:], levels[i:])
+			levels[i] = Level{Price: price, Size: size}
+		}
+	}
+	return levels
+}
+
+// search returns the index of price in the levels of a side, or where it would be inserted
+func search(levels []Level, price decimal.Decimal, side Side) (int, bool) {
+	i := sort.Search(len(levels), func(i int) bool {
+		if side == SideBid {
+			return levels[i].Price.LessThanOrEqual(price)
+		}
+		return levels[i].Price.GreaterThanOrEqual(price)
+	})
+	return i, i < len(levels) && levels[i].Price.Equal(price)
+}
+
+// versions parses the version range of a depth update, a missing start version
+// is the end version
+func versions(depth openapi.Depth) (int64, int64, error) {
+	endVersion, err := strconv.ParseInt(depth.GetEndVersion(), 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid depth end version: %s", depth.GetEndVersion())
+	}
+	if depth.GetStartVersion() == "" {
+		return endVersion, endVersion, nil
+	}
+	startVersion, err := strconv.ParseInt(depth.GetStartVersion(), 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid depth start version: %s", depth.GetStartVersion())
+	}
+	return startVersion, endVersion, nil
+}
@@ -0,0 +1,182 @@
+// Package pager iterates the records of paged endpoints across pages.
+//
+// The module targets Go 1.22, so pagers are driven with Next/Value/Err or
+// ForEach instead of iter.Seq2. With Go 1.23 a pager adapts to a range-over-func
+// iterator in a few lines by calling ForEach from the yield function.
+package pager
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// DefaultPageSize is the page size used when Options.PageSize is not set
+const DefaultPageSize = 100
+
+// FetchFunc fetches one page of at most size records starting at offset.
+// It returns the records and the offset of the next page, empty on the last page.
+type FetchFunc[T any] func(ctx context.Context, offset string, size int) ([]T, string, error)
+
+// Options tunes a pager
+type Options struct {
+	PageSize int // Records per request, 0 uses DefaultPageSize
+	MaxItems int // Maximum number of records to return, 0 means no limit
+}
+
+// Pager iterates the records of a paged endpoint, fetching pages on demand.
+// A Pager is not safe for concurrent use.
+//
+//	p := client.Order.ActiveOrdersPager(params, nil)
+//	for p.Next(ctx) {
+//		order := p.Value()
+//		...
+//	}
+//	if err := p.Err(); err != nil {
+//		...
+//	}
+type Pager[T any] struct {
+	fetch    FetchFunc[T]
+	pageSize int
+	maxItems int
+
+	page   []T
+	index  int
+	offset string
+	last   bool
+	count  int
+	value  T
+	err    error
+}
+
+// New creates a pager over fetch. A nil opts uses the defaults.
+func New[T any](fetch FetchFunc[T], opts *Options) *Pager[T] {
+	p := &Pager[T]{fetch: fetch, pageSize: DefaultPageSize}
+	if opts != nil {
+		if opts.PageSize > 0 {
+			p.pageSize = opts.PageSize
+		}
+		if opts.MaxItems > 0 {
+			p.maxItems = opts.MaxItems
+		}
+	}
+	return p
+}
+
+// Next advances to the next record, fetching the next page when needed.
+// It returns false when all records were returned, MaxItems was reached or
+// an error occurred; check Err afterwards.
+func (p *Pager[T]) Next(ctx context.Context) bool {
+	if p.err != nil || (p.maxItems > 0 && p.count >= p.maxItems) {
+		return false
+	}
+	for p.index >= len(p.page) {
+		if p.last {
+			return false
+		}
+		if err := ctx.Err(); err != nil {
+			p.err = err
+			return false
+		}
+
+		size := p.pageSize
+		if p.maxItems > 0 && p.maxItems-p.count < size {
+			size = p.maxItems - p.count
+		}
+		page, next, err := p.fetch(ctx, p.offset, size)
+		if err != nil {
+			p.err = err
+			return false
+		}
+		// Stop on the last page, and on a repeated offset so a misbehaving
+		// server cannot loop the pager forever
+		p.last = next == "" || next == p.offset || len(page) == 0
+		p.page, p.index, p.offset = page, 0, next
+	}
+
+	p.value = p.page[p.index]
+	p.index++
+	p.count++
+	return true
+}
+
+// Value returns the current record
+func (p *Pager[T]) Value() T {
+	return p.value
+}
+
+// Err returns the error that stopped the pager, if any
+func (p *Pager[T]) Err() error {
+	return p.err
+}
+
+// ForEach calls fn for each remaining record. It stops at the first error
+// returned by fn or the pager.
+func (p *Pager[T]) ForEach(ctx context.Context, fn func(T) error) error {
+	for p.Next(ctx) {
+		if err := fn(p.Value()); err != nil {
+			return err
+		}
+	}
+	return p.Err()
+}
+
+// All returns all remaining records
+func (p *Pager[T]) All(ctx context.Context) ([]T, error) {
+	var items []T
+	err := p.ForEach(ctx, func(item T) error {
+		items = append(items, item)
+		return nil
+	})
+	return items, err
+}
+
+// Window splits a time range into consecutive windows for Collect
+type Window struct {
+	Start time.Time     // Start of the range, inclusive
+	End   time.Time     // End of the range, exclusive
+	Span  time.Duration // Length of each window, 0 fetches the range at once
+}
+
+// Collect fetches all records of [Start, End) window by window, creating a pager
+// for each window with newPager. Splitting a large history export into windows
+// keeps each window's offset chain short. opts.MaxItems bounds the records over
+// all windows. The records are returned in window order, with the records
+// collected so far on error.
+func Collect[T any](ctx context.Context, w Window, opts *Options, newPager func(start, end time.Time, opts *Options) *Pager[T]) ([]T, error) {
+	if !w.End.After(w.Start) {
+		return nil, fmt.Errorf("window end %s must be after start %s", w.End, w.Start)
+	}
+	span := w.Span
+	if span <= 0 {
+		span = w.End.Sub(w.Start)
+	}
+	var windowOpts Options
+	if opts != nil {
+		windowOpts = *opts
+	}
+	maxItems := windowOpts.MaxItems
+
+	var items []T
+	for start := w.Start; start.Before(w.End); start = start.Add(span) {
+		end := start.Add(span)
+		if end.After(w.End) {
+			end = w.End
+		}
+		if maxItems > 0 {
+			windowOpts.MaxItems = maxItems - len(items)
+		}
+
+		err := newPager(start, end, &windowOpts).ForEach(ctx, func(item T) error {
+			items = append(items, item)
+			return nil
+		})
+		if err != nil {
+			return items, fmt.Errorf("failed to collect window %s - %s: %w", start.Format(time.RFC3339), end.Format(time.RFC3339), err)
+		}
+		if maxItems > 0 && len(items) >= maxItems {
+			break
+		}
+	}
+	return items, nil
+}
@@ -25,17 +25,14 @@ func NewClient(client *internal.Client, openapiClient *openapi.APIClient) *Clien
 
 // GetQuoteSummary gets the quote summary for a given contract
 func (c *Client) GetQuoteSummary(ctx context.Context, contractID string) (*openapi.ResultGetTickerSummaryModel, error) {
-	resp, _, err := c.openapiClient.Class01QuotePublicApiAPI.GetTicketSummary(ctx).
+	resp, httpResp, err := c.openapiClient.Class01QuotePublicApiAPI.GetTicketSummary(ctx).
 		Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get quote summary: %w", err)
+		return nil, fmt.Errorf("failed to get quote summary: %w", internal.ParseError(err, httpResp))
 	}
 
-	if resp.GetCode() != "SUCCESS" {
-		if errorParam := resp.GetErrorParam(); errorParam != nil {
-			return nil, fmt.Errorf("request failed with error params: %v", errorParam)
-		}
-		return nil, fmt.Errorf("request failed with code: %s", resp.GetCode())
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
 	}
 
 	return resp, nil
@@ -43,18 +40,15 @@ func (c *Client) GetQuoteSummary(ctx context.Context, contractID string) (*opena
 
 // Get24HourQuotes gets the 24-hour quotes for given contracts
 func (c *Client) Get24HourQuote(ctx context.Context, contractId string) (*openapi.ResultListTicker, error) {
-	resp, _, err := c.openapiClient.Class01QuotePublicApiAPI.GetTicker(ctx).
+	resp, httpResp, err := c.openapiClient.Class01QuotePublicApiAPI.GetTicker(ctx).
 		ContractId(contractId). // API only supports one contract ID
 		Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get 24-hour quotes: %w", err)
+		return nil, fmt.Errorf("failed to get 24-hour quotes: %w", internal.ParseError(err, httpResp))
 	}
 
-	if resp.GetCode() != "SUCCESS" {
-		if errorParam := resp.GetErrorParam(); errorParam != nil {
-			return nil, fmt.Errorf("request failed with error params: %v", errorParam)
-		}
-		return nil, fmt.Errorf("request failed with code: %s", resp.GetCode())
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
 	}
 
 	return resp, nil
@@ -87,16 +81,13 @@ func (c *Client) GetKLine(ctx context.Context, params GetKLineParams) (*openapi.
 		req = req.FilterEndKlineTimeExclusive(fmt.Sprintf("%d", *params.To))
 	}
 
-	resp, _, err := req.Execute()
+	resp, httpResp, err := req.Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get k-line data: %w", err)
+		return nil, fmt.Errorf("failed to get k-line data: %w", internal.ParseError(err, httpResp))
 	}
 
-	if resp.GetCode() != "SUCCESS" {
-		if errorParam := resp.GetErrorParam(); errorParam != nil {
-			return nil, fmt.Errorf("request failed with error params: %v", errorParam)
-		}
-		return nil, fmt.Errorf("request failed with code: %s", resp.GetCode())
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
 	}
 
 	return resp, nil
@@ -115,16 +106,13 @@ func (c *Client) GetOrderBookDepth(ctx context.Context, params GetOrderBookDepth
 		ContractId(params.ContractID).
 		Level(fmt.Sprintf("%d", params.Size))
 
-	resp, _, err := req.Execute()
+	resp, httpResp, err := req.Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get order book depth: %w", err)
+		return nil, fmt.Errorf("failed to get order book depth: %w", internal.ParseError(err, httpResp))
 	}
 
-	if resp.GetCode() != "SUCCESS" {
-		if errorParam := resp.GetErrorParam(); errorParam != nil {
-			return nil, fmt.Errorf("request failed with error params: %v", errorParam)
-		}
-		return nil, fmt.Errorf("request failed with code: %s", resp.GetCode())
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
 	}
 
 	return resp, nil
@@ -157,16 +145,13 @@ func (c *Client) GetMultiContractKLine(ctx context.Context, params GetMultiContr
 		req = req.FilterEndKlineTimeExclusive(fmt.Sprintf("%d", *params.To))
 	}
 
-	resp, _, err := req.Execute()
+	resp, httpResp, err := req.Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get multi-contract k-line data: %w", err)
+		return nil, fmt.Errorf("failed to get multi-contract k-line data: %w", internal.ParseError(err, httpResp))
 	}
 
-	if resp.GetCode() != "SUCCESS" {
-		if errorParam := resp.GetErrorParam(); errorParam != nil {
-			return nil, fmt.Errorf("request failed with error params: %v", errorParam)
-		}
-		return nil, fmt.Errorf("request failed with code: %s", resp.GetCode())
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
 	}
 
 	return resp, nil
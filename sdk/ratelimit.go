@@ -0,0 +1,107 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/internal"
+)
+
+// RateLimitConfig configures the client side rate limiter.
+// Each group of endpoints has its own token bucket, a zero rate disables the bucket.
+type RateLimitConfig struct {
+	PublicPerSecond  float64       // Requests per second for public endpoints
+	PublicBurst      int           // Burst size for public endpoints
+	PrivatePerSecond float64       // Requests per second for private read endpoints
+	PrivateBurst     int           // Burst size for private read endpoints
+	OrderPerMinute   int           // Order placements and cancels per minute
+	OrderBurst       int           // Burst size for order placements and cancels
+	OrderDelay       time.Duration // Minimum interval between order placements and cancels
+	FailFast         bool          // Return ErrRateLimited instead of waiting for a token
+}
+
+// orderEndpoints lists the private endpoints limited by the order bucket
+var orderEndpoints = []string{
+	"/api/v1/private/order/createOrder",
+	"/api/v1/private/order/cancelOrderById",
+	"/api/v1/private/order/cancelOrderByClientOrderId",
+	"/api/v1/private/order/cancelAllOrder",
+}
+
+// rateLimitTransport implements http.RoundTripper and throttles requests
+// before they are signed
+type rateLimitTransport struct {
+	transport http.RoundTripper
+	public    *internal.TokenBucket
+	private   *internal.TokenBucket
+	order     *internal.TokenBucket
+	failFast  bool
+}
+
+// newRateLimitTransport creates a rate limiting transport from the config
+func newRateLimitTransport(transport http.RoundTripper, cfg *RateLimitConfig) *rateLimitTransport {
+	return &rateLimitTransport{
+		transport: transport,
+		public:    internal.NewTokenBucket(cfg.PublicPerSecond, cfg.PublicBurst, 0),
+		private:   internal.NewTokenBucket(cfg.PrivatePerSecond, cfg.PrivateBurst, 0),
+		order:     internal.NewTokenBucket(float64(cfg.OrderPerMinute)/60, cfg.OrderBurst, cfg.OrderDelay),
+		failFast:  cfg.FailFast,
+	}
+}
+
+// bucket returns the token bucket limiting the given request path
+func (t *rateLimitTransport) bucket(path string) *internal.TokenBucket {
+	for _, endpoint := range orderEndpoints {
+		if strings.HasSuffix(path, endpoint) {
+			return t.order
+		}
+	}
+	if strings.Contains(path, "/api/v1/public/") {
+		return t.public
+	}
+	return t.private
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	bucket := t.bucket(req.URL.Path)
+	if t.failFast {
+		if !bucket.Allow() {
+			return nil, fmt.Errorf("client side rate limit exceeded for %s: %w", req.URL.Path, ErrRateLimited)
+		}
+	} else if err := bucket.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+	return t.transport.RoundTrip(req)
+}
+
+// ConfigureRateLimitFromAccount sets the order bucket from the account's
+// CreateOrderPerMinuteLimit and CreateOrderDelayMillis settings.
+// The client must have been created with a RateLimitConfig.
+func (c *Client) ConfigureRateLimitFromAccount(ctx context.Context) error {
+	if c.rateLimiter == nil {
+		return fmt.Errorf("rate limiter not configured")
+	}
+
+	resp, err := c.Account.GetAccountByID(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get account: %w", err)
+	}
+
+	account := resp.GetData()
+	perMinute := int(account.GetCreateOrderPerMinuteLimit())
+	if perMinute <= 0 {
+		perMinute = c.rateLimitConfig.OrderPerMinute
+	}
+	delay := time.Duration(account.GetCreateOrderDelayMillis()) * time.Millisecond
+
+	burst := c.rateLimitConfig.OrderBurst
+	if burst <= 0 || burst > perMinute {
+		burst = perMinute
+	}
+	c.rateLimiter.order.SetLimit(float64(perMinute)/60, burst, delay)
+	return nil
+}
@@ -44,16 +44,13 @@ func (c *Client) GetTransferOutById(ctx context.Context, params GetTransferOutBy
 	// Set account ID
 	req = req.AccountId(strconv.FormatInt(c.GetAccountID(), 10))
 
-	resp, _, err := req.Execute()
+	resp, httpResp, err := req.Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get transfer out by id: %w", err)
+		return nil, fmt.Errorf("failed to get transfer out by id: %w", internal.ParseError(err, httpResp))
 	}
 
-	if resp.GetCode() != "SUCCESS" {
-		if errorParam := resp.GetErrorParam(); errorParam != nil {
-			return nil, fmt.Errorf("request failed with error params: %v", errorParam)
-		}
-		return nil, fmt.Errorf("request failed with code: %s", resp.GetCode())
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
 	}
 
 	return resp, nil
@@ -75,16 +72,116 @@ func (c *Client) GetTransferInById(ctx context.Context, params GetTransferInById
 	// Set account ID
 	req = req.AccountId(strconv.FormatInt(c.GetAccountID(), 10))
 
-	resp, _, err := req.Execute()
+	resp, httpResp, err := req.Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get transfer in by id: %w", err)
+		return nil, fmt.Errorf("failed to get transfer in by id: %w", internal.ParseError(err, httpResp))
 	}
 
-	if resp.GetCode() != "SUCCESS" {
-		if errorParam := resp.GetErrorParam(); errorParam != nil {
-			return nil, fmt.Errorf("request failed with error params: %v", errorParam)
-		}
-		return nil, fmt.Errorf("request failed with code: %s", resp.GetCode())
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// TransferFilterParams represents the filters of active transfer listings
+type TransferFilterParams struct {
+	FilterCoinIdList         []string // Filter by coin IDs, empty means all coins
+	FilterStatusList         []string // Filter by transfer statuses
+	FilterTransferReasonList []string // Filter by transfer reasons
+
+	// Time filters
+	FilterStartCreatedTimeInclusive uint64 // Filter start time (inclusive), 0 means from earliest
+	FilterEndCreatedTimeExclusive   uint64 // Filter end time (exclusive), 0 means until latest
+}
+
+// GetActiveTransferOutParams represents the parameters for GetActiveTransferOut
+type GetActiveTransferOutParams struct {
+	Size       string // Size of the page, must be greater than 0 and less than or equal to 100
+	OffsetData string // Offset data for pagination. Empty string gets the first page
+	TransferFilterParams
+}
+
+// GetActiveTransferOut gets the active transfer out records of the account with pagination
+func (c *Client) GetActiveTransferOut(ctx context.Context, params GetActiveTransferOutParams) (*openapi.ResultPageDataTransferOut, error) {
+	req := c.openapiClient.Class07TransferPrivateApiAPI.GetActiveTransferOut(ctx).
+		AccountId(strconv.FormatInt(c.GetAccountID(), 10))
+
+	if params.Size != "" {
+		req = req.Size(params.Size)
+	}
+	if params.OffsetData != "" {
+		req = req.OffsetData(params.OffsetData)
+	}
+	if len(params.FilterCoinIdList) > 0 {
+		req = req.FilterCoinIdList(internal.JoinStrings(params.FilterCoinIdList))
+	}
+	if len(params.FilterStatusList) > 0 {
+		req = req.FilterStatusList(internal.JoinStrings(params.FilterStatusList))
+	}
+	if len(params.FilterTransferReasonList) > 0 {
+		req = req.FilterTransferReasonList(internal.JoinStrings(params.FilterTransferReasonList))
+	}
+	if params.FilterStartCreatedTimeInclusive > 0 {
+		req = req.FilterStartCreatedTimeInclusive(strconv.FormatUint(params.FilterStartCreatedTimeInclusive, 10))
+	}
+	if params.FilterEndCreatedTimeExclusive > 0 {
+		req = req.FilterEndCreatedTimeExclusive(strconv.FormatUint(params.FilterEndCreatedTimeExclusive, 10))
+	}
+
+	resp, httpResp, err := req.Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active transfer out: %w", internal.ParseError(err, httpResp))
+	}
+
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetActiveTransferInParams represents the parameters for GetActiveTransferIn
+type GetActiveTransferInParams struct {
+	Size       string // Size of the page, must be greater than 0 and less than or equal to 100
+	OffsetData string // Offset data for pagination. Empty string gets the first page
+	TransferFilterParams
+}
+
+// GetActiveTransferIn gets the active transfer in records of the account with pagination
+func (c *Client) GetActiveTransferIn(ctx context.Context, params GetActiveTransferInParams) (*openapi.ResultPageDataTransferIn, error) {
+	req := c.openapiClient.Class07TransferPrivateApiAPI.GetActiveTransferIn(ctx).
+		AccountId(strconv.FormatInt(c.GetAccountID(), 10))
+
+	if params.Size != "" {
+		req = req.Size(params.Size)
+	}
+	if params.OffsetData != "" {
+		req = req.OffsetData(params.OffsetData)
+	}
+	if len(params.FilterCoinIdList) > 0 {
+		req = req.FilterCoinIdList(internal.JoinStrings(params.FilterCoinIdList))
+	}
+	if len(params.FilterStatusList) > 0 {
+		req = req.FilterStatusList(internal.JoinStrings(params.FilterStatusList))
+	}
+	if len(params.FilterTransferReasonList) > 0 {
+		req = req.FilterTransferReasonList(internal.JoinStrings(params.FilterTransferReasonList))
+	}
+	if params.FilterStartCreatedTimeInclusive > 0 {
+		req = req.FilterStartCreatedTimeInclusive(strconv.FormatUint(params.FilterStartCreatedTimeInclusive, 10))
+	}
+	if params.FilterEndCreatedTimeExclusive > 0 {
+		req = req.FilterEndCreatedTimeExclusive(strconv.FormatUint(params.FilterEndCreatedTimeExclusive, 10))
+	}
+
+	resp, httpResp, err := req.Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active transfer in: %w", internal.ParseError(err, httpResp))
+	}
+
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
 	}
 
 	return resp, nil
@@ -106,16 +203,13 @@ func (c *Client) GetWithdrawAvailableAmount(ctx context.Context, params GetWithd
 	// Set account ID
 	req = req.AccountId(strconv.FormatInt(c.GetAccountID(), 10))
 
-	resp, _, err := req.Execute()
+	resp, httpResp, err := req.Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get available withdrawal amount: %w", err)
+		return nil, fmt.Errorf("failed to get available withdrawal amount: %w", internal.ParseError(err, httpResp))
 	}
 
-	if resp.GetCode() != "SUCCESS" {
-		if errorParam := resp.GetErrorParam(); errorParam != nil {
-			return nil, fmt.Errorf("request failed with error params: %v", errorParam)
-		}
-		return nil, fmt.Errorf("request failed with code: %s", resp.GetCode())
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
 	}
 
 	return resp, nil
@@ -129,6 +223,11 @@ type CreateTransferOutParams struct {
 	ReceiverL2Key     string
 	ClientTransferId  string
 	TransferReason    string
+
+	// Transfer expiry, either absolute or relative to now. When neither is
+	// set the L2 signature expires after 14 days.
+	ExpireTime  *time.Time
+	ExpireAfter time.Duration
 }
 
 // CreateTransferOut creates a new transfer out order
@@ -139,19 +238,24 @@ func (c *Client) CreateTransferOut(ctx context.Context, params CreateTransferOut
 	accountId := strconv.FormatInt(c.GetAccountID(), 10)
 	createTransferOutParam.SetAccountId(accountId)
 
-	// Generate client transfer ID if not provided
+	// Generate client transfer ID if not provided. A caller supplied ID is
+	// deduplicated by the server, so the request can be retried
 	if params.ClientTransferId == "" {
 		params.ClientTransferId = internal.GenerateUUID()
+	} else {
+		ctx = internal.WithIdempotent(ctx)
 	}
 
-	l2ExpireTime := strconv.FormatInt(time.Now().Add(14*24*time.Hour).UnixMilli(), 10)
+	// Transfers only carry the L2 expire time
+	expiry, err := internal.ResolveL2Expiry(time.Now(), params.ExpireTime, params.ExpireAfter)
+	if err != nil {
+		return nil, err
+	}
 
 	// Convert parameters to appropriate types for hash calculation
 	amountDm, _ := decimal.NewFromString(params.Amount)
 	amount := amountDm.Shift(6).IntPart()
 	nonce := internal.CalcNonce(params.ClientTransferId)
-	expireTime, _ := strconv.ParseInt(l2ExpireTime, 10, 64)
-	expireTimeUnix := expireTime / (60 * 60 * 1000) // Convert to hours
 
 	// Remove 0x prefix from receiver L2 key if present
 	receiverL2Key := strings.TrimPrefix(params.ReceiverL2Key, "0x")
@@ -191,7 +295,7 @@ func (c *Client) CreateTransferOut(ctx context.Context, params CreateTransferOut
 		nonce,
 		amount,
 		maxAmountFee,
-		expireTimeUnix,
+		expiry.L2ExpireHour(),
 	)
 	signature, err := c.Sign(msgHash)
 	if err != nil {
@@ -206,23 +310,20 @@ func (c *Client) CreateTransferOut(ctx context.Context, params CreateTransferOut
 	createTransferOutParam.SetClientTransferId(params.ClientTransferId)
 	createTransferOutParam.SetTransferReason(params.TransferReason)
 	createTransferOutParam.SetL2Nonce(strconv.FormatInt(nonce, 10))
-	createTransferOutParam.SetL2ExpireTime(l2ExpireTime)
+	createTransferOutParam.SetL2ExpireTime(strconv.FormatInt(expiry.L2ExpireTime, 10))
 	createTransferOutParam.SetL2Signature(fmt.Sprintf("%s%s%s", signature.R, signature.S, signature.V))
 
 	// Execute the request
 	req := c.openapiClient.Class07TransferPrivateApiAPI.CreateTransferOut(ctx)
 	req = req.CreateTransferOutParam(createTransferOutParam)
 
-	resp, _, err := req.Execute()
+	resp, httpResp, err := req.Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to create transfer out: %w", err)
+		return nil, fmt.Errorf("failed to create transfer out: %w", internal.ParseError(err, httpResp))
 	}
 
-	if resp.GetCode() != "SUCCESS" {
-		if errorParam := resp.GetErrorParam(); errorParam != nil {
-			return nil, fmt.Errorf("request failed with error params: %v", errorParam)
-		}
-		return nil, fmt.Errorf("request failed with code: %s", resp.GetCode())
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
 	}
 
 	return resp, nil
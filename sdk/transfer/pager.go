@@ -0,0 +1,41 @@
+package transfer
+
+import (
+	"context"
+	"strconv"
+
+	openapi "github.com/edgex-Tech/edgex-golang-sdk/openapi"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/pager"
+)
+
+// ActiveTransferOutsPager returns a pager over all active transfer out records matching the filters
+func (c *Client) ActiveTransferOutsPager(filter TransferFilterParams, opts *pager.Options) *pager.Pager[openapi.TransferOut] {
+	return pager.New(func(ctx context.Context, offset string, size int) ([]openapi.TransferOut, string, error) {
+		resp, err := c.GetActiveTransferOut(ctx, GetActiveTransferOutParams{
+			Size:                 strconv.Itoa(size),
+			OffsetData:           offset,
+			TransferFilterParams: filter,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		data := resp.GetData()
+		return data.GetDataList(), data.GetNextPageOffsetData(), nil
+	}, opts)
+}
+
+// ActiveTransferInsPager returns a pager over all active transfer in records matching the filters
+func (c *Client) ActiveTransferInsPager(filter TransferFilterParams, opts *pager.Options) *pager.Pager[openapi.TransferIn] {
+	return pager.New(func(ctx context.Context, offset string, size int) ([]openapi.TransferIn, string, error) {
+		resp, err := c.GetActiveTransferIn(ctx, GetActiveTransferInParams{
+			Size:                 strconv.Itoa(size),
+			OffsetData:           offset,
+			TransferFilterParams: filter,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		data := resp.GetData()
+		return data.GetDataList(), data.GetNextPageOffsetData(), nil
+	}, opts)
+}
@@ -0,0 +1,122 @@
+package transfer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	openapi "github.com/edgex-Tech/edgex-golang-sdk/openapi"
+)
+
+// TransferStatus represents the status of a transfer. A transfer is checked and
+// censored, which moves the funds on success, and then approved or rejected on L2.
+type TransferStatus string
+
+const (
+	TransferStatusPendingChecking  TransferStatus = "PENDING_CHECKING"
+	TransferStatusPendingCensoring TransferStatus = "PENDING_CENSORING"
+	TransferStatusCensorSuccess    TransferStatus = "SUCCESS_CENSOR_SUCCESS"
+	TransferStatusL2Approved       TransferStatus = "SUCCESS_L2_APPROVED"
+	TransferStatusCheckInvalid     TransferStatus = "FAILED_CHECK_INVALID"
+	TransferStatusCensorFailure    TransferStatus = "FAILED_CENSOR_FAILURE"
+	TransferStatusL2Reject         TransferStatus = "FAILED_L2_REJECT"
+	TransferStatusL2RejectApproved TransferStatus = "FAILED_L2_REJECT_APPROVED"
+	TransferStatusUnknown          TransferStatus = "UNKNOWN_TRANSFER_STATUS"
+)
+
+// IsSuccess reports whether the transfer passed censoring and its funds are moved
+func (s TransferStatus) IsSuccess() bool {
+	return s == TransferStatusCensorSuccess || s == TransferStatusL2Approved
+}
+
+// IsFailed reports whether the transfer was rejected by checking, censoring or on L2
+func (s TransferStatus) IsFailed() bool {
+	return s == TransferStatusCheckInvalid || s == TransferStatusCensorFailure ||
+		s == TransferStatusL2Reject || s == TransferStatusL2RejectApproved
+}
+
+// IsFinal reports whether the status can no longer change
+func (s TransferStatus) IsFinal() bool {
+	return s == TransferStatusL2Approved || s == TransferStatusCheckInvalid ||
+		s == TransferStatusCensorFailure || s == TransferStatusL2RejectApproved
+}
+
+// DefaultPollInterval is the default interval between transfer status checks
+const DefaultPollInterval = 5 * time.Second
+
+// ErrTransferFailed is returned by WaitForTransfer when the transfer was rejected
+var ErrTransferFailed = errors.New("transfer failed")
+
+// WaitForTransferParams represents the parameters for WaitForTransfer
+type WaitForTransferParams struct {
+	// PollInterval is the interval between status checks, 0 uses DefaultPollInterval
+	PollInterval time.Duration
+	// UntilMoved returns once the funds are moved instead of waiting for L2 approval
+	UntilMoved bool
+	// OnUpdate is called whenever the status of the transfer changes
+	OnUpdate func(transfer *openapi.TransferOut)
+}
+
+// WaitForTransfer polls a transfer out until it reaches a final status, or its funds
+// are moved when UntilMoved is set. It returns the last state of the transfer, with
+// an error wrapping ErrTransferFailed if the transfer was rejected. Bound the wait
+// with the deadline of ctx.
+func (c *Client) WaitForTransfer(ctx context.Context, transferOutId string, params *WaitForTransferParams) (*openapi.TransferOut, error) {
+	if params == nil {
+		params = &WaitForTransferParams{}
+	}
+	interval := params.PollInterval
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var lastStatus string
+	for {
+		resp, err := c.GetTransferOutById(ctx, GetTransferOutByIdParams{TransferId: transferOutId})
+		if err != nil {
+			return nil, err
+		}
+		transfers := resp.GetData()
+		if len(transfers) == 0 {
+			return nil, fmt.Errorf("transfer out %s not found", transferOutId)
+		}
+		transfer := &transfers[0]
+
+		if transfer.GetStatus() != lastStatus {
+			lastStatus = transfer.GetStatus()
+			if params.OnUpdate != nil {
+				params.OnUpdate(transfer)
+			}
+		}
+
+		status := TransferStatus(transfer.GetStatus())
+		if status.IsFailed() {
+			return transfer, fmt.Errorf("%w: %s", ErrTransferFailed, FailureReason(transfer))
+		}
+		if status.IsFinal() || (params.UntilMoved && status.IsSuccess()) {
+			return transfer, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return transfer, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// FailureReason describes why a transfer failed, or returns an empty string
+func FailureReason(transfer *openapi.TransferOut) string {
+	switch TransferStatus(transfer.GetStatus()) {
+	case TransferStatusCheckInvalid:
+		return "check invalid"
+	case TransferStatusCensorFailure:
+		return fmt.Sprintf("censor failure %s: %s", transfer.GetCensorFailCode(), transfer.GetCensorFailReason())
+	case TransferStatusL2Reject, TransferStatusL2RejectApproved:
+		return fmt.Sprintf("l2 reject %s: %s", transfer.GetL2RejectCode(), transfer.GetL2RejectReason())
+	}
+	return ""
+}
@@ -0,0 +1,223 @@
+package withdraw
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	openapi "github.com/edgex-Tech/edgex-golang-sdk/openapi"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/internal"
+	"github.com/shopspring/decimal"
+)
+
+// Client represents the withdraw client
+type Client struct {
+	*internal.Client
+	openapiClient *openapi.APIClient
+}
+
+// NewClient creates a new withdraw client
+func NewClient(client *internal.Client, openapiClient *openapi.APIClient) *Client {
+	return &Client{
+		Client:        client,
+		openapiClient: openapiClient,
+	}
+}
+
+// CreateWithdrawParams represents the parameters for CreateWithdraw
+type CreateWithdrawParams struct {
+	CoinId           string
+	Amount           string
+	EthAddress       string // L1 address registered with the stark key of the account
+	Erc20Address     string // Contract address of the withdrawn coin
+	ClientWithdrawId string // Client defined ID, generated if empty
+	RiskSignature    string
+	ExtraType        string
+	ExtraDataJson    string
+
+	// Withdrawal expiry, either absolute or relative to now. When neither is
+	// set the L2 signature expires after 14 days.
+	ExpireTime  *time.Time
+	ExpireAfter time.Duration
+}
+
+// CreateWithdraw creates a signed withdrawal to the L1 address registered with the
+// stark key of the account
+func (c *Client) CreateWithdraw(ctx context.Context, params CreateWithdrawParams, metadata openapi.MetaData) (*openapi.ResultCreateWithdraw, error) {
+	// A caller supplied ID is deduplicated by the server, so the request can be retried
+	if params.ClientWithdrawId == "" {
+		params.ClientWithdrawId = internal.GenerateUUID()
+	} else {
+		ctx = internal.WithIdempotent(ctx)
+	}
+
+	expiry, err := internal.ResolveL2Expiry(time.Now(), params.ExpireTime, params.ExpireAfter)
+	if err != nil {
+		return nil, err
+	}
+
+	amountDm, err := decimal.NewFromString(params.Amount)
+	if err != nil || !amountDm.IsPositive() {
+		return nil, fmt.Errorf("invalid withdraw amount: %s", params.Amount)
+	}
+	amount := amountDm.Shift(6).IntPart()
+	nonce := internal.CalcNonce(params.ClientWithdrawId)
+
+	assetID, err := internal.CoinAssetID(metadata, params.CoinId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Calculate withdrawal hash and sign it, the position ID is the account ID
+	msgHash := internal.CalcWithdrawalHash(assetID, c.GetAccountID(), nonce, amount, expiry.L2ExpireHour())
+	signature, err := c.Sign(msgHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign withdrawal hash: %w", err)
+	}
+
+	param := openapi.CreateWithdrawParam{}
+	param.SetAccountId(strconv.FormatInt(c.GetAccountID(), 10))
+	param.SetCoinId(params.CoinId)
+	param.SetAmount(amountDm.String())
+	param.SetClientWithdrawId(params.ClientWithdrawId)
+	param.SetL2Nonce(strconv.FormatInt(nonce, 10))
+	param.SetL2ExpireTime(strconv.FormatInt(expiry.L2ExpireTime, 10))
+	param.SetL2Signature(fmt.Sprintf("%s%s%s", signature.R, signature.S, signature.V))
+	if params.EthAddress != "" {
+		param.SetEthAddress(params.EthAddress)
+	}
+	if params.Erc20Address != "" {
+		param.SetErc20Address(params.Erc20Address)
+	}
+	if params.RiskSignature != "" {
+		param.SetRiskSignature(params.RiskSignature)
+	}
+	if params.ExtraType != "" {
+		param.SetExtraType(params.ExtraType)
+	}
+	if params.ExtraDataJson != "" {
+		param.SetExtraDataJson(params.ExtraDataJson)
+	}
+
+	resp, httpResp, err := c.openapiClient.Class06WithdrawPrivateApiAPI.CreateWithdraw(ctx).
+		CreateWithdrawParam(param).
+		Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create withdraw: %w", internal.ParseError(err, httpResp))
+	}
+
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetActiveWithdrawParams represents the parameters for GetActiveWithdraw
+type GetActiveWithdrawParams struct {
+	Size       string // Size of the page, must be greater than 0 and less than or equal to 100
+	OffsetData string // Offset data for pagination. Empty string gets the first page
+
+	FilterCoinIdList []string // Filter by coin IDs, empty means all coins
+	FilterStatusList []string // Filter by withdrawal statuses
+
+	// Time filters
+	FilterStartCreatedTimeInclusive uint64 // Filter start time (inclusive), 0 means from earliest
+	FilterEndCreatedTimeExclusive   uint64 // Filter end time (exclusive), 0 means until latest
+}
+
+// GetActiveWithdraw gets the active withdrawals of the account with pagination
+func (c *Client) GetActiveWithdraw(ctx context.Context, params GetActiveWithdrawParams) (*openapi.ResultPageDataWithdraw, error) {
+	req := c.openapiClient.Class06WithdrawPrivateApiAPI.GetActiveWithdraw(ctx).
+		AccountId(strconv.FormatInt(c.GetAccountID(), 10))
+
+	if params.Size != "" {
+		req = req.Size(params.Size)
+	}
+	if params.OffsetData != "" {
+		req = req.OffsetData(params.OffsetData)
+	}
+	if len(params.FilterCoinIdList) > 0 {
+		req = req.FilterCoinIdList(internal.JoinStrings(params.FilterCoinIdList))
+	}
+	if len(params.FilterStatusList) > 0 {
+		req = req.FilterStatusList(internal.JoinStrings(params.FilterStatusList))
+	}
+	if params.FilterStartCreatedTimeInclusive > 0 {
+		req = req.FilterStartCreatedTimeInclusive(strconv.FormatUint(params.FilterStartCreatedTimeInclusive, 10))
+	}
+	if params.FilterEndCreatedTimeExclusive > 0 {
+		req = req.FilterEndCreatedTimeExclusive(strconv.FormatUint(params.FilterEndCreatedTimeExclusive, 10))
+	}
+
+	resp, httpResp, err := req.Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active withdraws: %w", internal.ParseError(err, httpResp))
+	}
+
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetWithdrawById gets withdrawals by withdraw IDs
+func (c *Client) GetWithdrawById(ctx context.Context, withdrawIds []string) (*openapi.ResultListWithdraw, error) {
+	if len(withdrawIds) == 0 {
+		return nil, fmt.Errorf("at least one withdrawId is required")
+	}
+
+	resp, httpResp, err := c.openapiClient.Class06WithdrawPrivateApiAPI.GetWithdrawById(ctx).
+		AccountId(strconv.FormatInt(c.GetAccountID(), 10)).
+		WithdrawIdList(internal.JoinStrings(withdrawIds)).
+		Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get withdraw by id: %w", internal.ParseError(err, httpResp))
+	}
+
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetWithdrawByClientWithdrawId gets withdrawals by client withdraw IDs
+func (c *Client) GetWithdrawByClientWithdrawId(ctx context.Context, clientWithdrawIds []string) (*openapi.ResultListWithdraw, error) {
+	if len(clientWithdrawIds) == 0 {
+		return nil, fmt.Errorf("at least one clientWithdrawId is required")
+	}
+
+	resp, httpResp, err := c.openapiClient.Class06WithdrawPrivateApiAPI.GetWithdrawByClientWithdrawId(ctx).
+		AccountId(strconv.FormatInt(c.GetAccountID(), 10)).
+		ClientWithdrawIdList(internal.JoinStrings(clientWithdrawIds)).
+		Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get withdraw by client withdraw id: %w", internal.ParseError(err, httpResp))
+	}
+
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetWithdrawAvailableAmount gets the amount of a coin available for withdrawal
+func (c *Client) GetWithdrawAvailableAmount(ctx context.Context, coinId string) (*openapi.ResultGetWithdrawAvailableAmount, error) {
+	resp, httpResp, err := c.openapiClient.Class06WithdrawPrivateApiAPI.GetWithdrawAvailableAmount(ctx).
+		AccountId(strconv.FormatInt(c.GetAccountID(), 10)).
+		CoinId(coinId).
+		Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get available withdrawal amount: %w", internal.ParseError(err, httpResp))
+	}
+
+	if err := internal.CheckResponse(resp, httpResp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
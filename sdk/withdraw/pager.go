@@ -0,0 +1,24 @@
+package withdraw
+
+import (
+	"context"
+	"strconv"
+
+	openapi "github.com/edgex-Tech/edgex-golang-sdk/openapi"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/pager"
+)
+
+// ActiveWithdrawsPager returns a pager over all active withdrawals matching the filters of params
+func (c *Client) ActiveWithdrawsPager(params GetActiveWithdrawParams, opts *pager.Options) *pager.Pager[openapi.Withdraw] {
+	return pager.New(func(ctx context.Context, offset string, size int) ([]openapi.Withdraw, string, error) {
+		p := params
+		p.Size = strconv.Itoa(size)
+		p.OffsetData = offset
+		resp, err := c.GetActiveWithdraw(ctx, p)
+		if err != nil {
+			return nil, "", err
+		}
+		data := resp.GetData()
+		return data.GetDataList(), data.GetNextPageOffsetData(), nil
+	}, opts)
+}
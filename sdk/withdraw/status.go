@@ -0,0 +1,139 @@
+package withdraw
+
+import (
+	"strings"
+	"time"
+
+	openapi "github.com/edgex-Tech/edgex-golang-sdk/openapi"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/internal"
+)
+
+// Kind identifies the API a withdrawal was created through
+type Kind string
+
+const (
+	KindWithdraw       Kind = "WITHDRAW"        // Withdrawal to the registered L1 address
+	KindNormalWithdraw Kind = "NORMAL_WITHDRAW" // Withdrawal to any L1 address
+	KindFastWithdraw   Kind = "FAST_WITHDRAW"   // Withdrawal paid out by the fast withdraw liquidity provider
+	KindCrossWithdraw  Kind = "CROSS_WITHDRAW"  // Withdrawal paid out on another chain
+)
+
+// State is the status of a withdrawal reduced to pending, succeeded or failed
+type State string
+
+const (
+	StatePending   State = "PENDING"
+	StateSucceeded State = "SUCCEEDED"
+	StateFailed    State = "FAILED"
+)
+
+// IsFinal reports whether the state can no longer change
+func (s State) IsFinal() bool {
+	return s == StateSucceeded || s == StateFailed
+}
+
+// StateOf returns the state of a withdrawal status of any kind
+func StateOf(status string) State {
+	switch {
+	case strings.Contains(status, "FAILED_"):
+		return StateFailed
+	case status == "SUCCESS_L2_APPROVED",
+		status == "NORMAL_WITHDRAW_SUCCESS_L1_COMPLETED",
+		strings.HasSuffix(status, "_WITHDRAW_SUCCESS"):
+		return StateSucceeded
+	default:
+		return StatePending
+	}
+}
+
+// Withdrawal is a withdrawal of any kind in a common shape
+type Withdrawal struct {
+	Kind             Kind
+	Id               string
+	ClientWithdrawId string
+	CoinId           string
+	Amount           string
+	Fee              string // Liquidity provider fee, empty for withdrawals without one
+	EthAddress       string
+	Erc20Address     string
+	ChainId          string // Destination chain, empty for withdrawals to the settlement chain
+	Status           string // Status as reported by the API
+	State            State
+	FailureReason    string
+	CreatedTime      time.Time
+	UpdatedTime      time.Time
+}
+
+// FromWithdraw converts a withdrawal created through CreateWithdraw
+func FromWithdraw(w openapi.Withdraw) Withdrawal {
+	return Withdrawal{
+		Kind:             KindWithdraw,
+		Id:               w.GetId(),
+		ClientWithdrawId: w.GetClientWithdrawId(),
+		CoinId:           w.GetCoinId(),
+		Amount:           w.GetAmount(),
+		EthAddress:       w.GetEthAddress(),
+		Erc20Address:     w.GetErc20Address(),
+		Status:           w.GetStatus(),
+		State:            StateOf(w.GetStatus()),
+		FailureReason:    internal.FirstNonEmpty(w.GetCensorFailReason(), w.GetL2RejectReason()),
+		CreatedTime:      internal.ParseMillis(w.GetCreatedTime()),
+		UpdatedTime:      internal.ParseMillis(w.GetUpdatedTime()),
+	}
+}
+
+// FromNormalWithdraw converts a normal withdrawal
+func FromNormalWithdraw(w openapi.NormalWithdraw) Withdrawal {
+	return Withdrawal{
+		Kind:             KindNormalWithdraw,
+		Id:               w.GetId(),
+		ClientWithdrawId: w.GetClientWithdrawId(),
+		CoinId:           w.GetCoinId(),
+		Amount:           w.GetAmount(),
+		EthAddress:       w.GetEthAddress(),
+		Status:           w.GetStatus(),
+		State:            StateOf(w.GetStatus()),
+		CreatedTime:      internal.ParseMillis(w.GetCreatedTime()),
+		UpdatedTime:      internal.ParseMillis(w.GetUpdatedTime()),
+	}
+}
+
+// FromFastWithdraw converts a fast withdrawal
+func FromFastWithdraw(w openapi.FastWithdraw) Withdrawal {
+	return Withdrawal{
+		Kind:             KindFastWithdraw,
+		Id:               w.GetId(),
+		ClientWithdrawId: w.GetClientFastWithdrawId(),
+		CoinId:           w.GetCoinId(),
+		Amount:           w.GetAmount(),
+		Fee:              w.GetFee(),
+		EthAddress:       w.GetEthAddress(),
+		Erc20Address:     w.GetErc20Address(),
+		ChainId:          w.GetChainId(),
+		Status:           w.GetStatus(),
+		State:            StateOf(w.GetStatus()),
+		FailureReason:    internal.FirstNonEmpty(w.GetCensorFailReason(), w.GetL2RejectReason(), w.GetL1RejectedReasonMsg()),
+		CreatedTime:      internal.ParseMillis(w.GetCreatedTime()),
+		UpdatedTime:      internal.ParseMillis(w.GetUpdatedTime()),
+	}
+}
+
+// FromCrossWithdraw converts a cross-chain withdrawal
+func FromCrossWithdraw(w openapi.CrossWithdraw) Withdrawal {
+	return Withdrawal{
+		Kind:             KindCrossWithdraw,
+		Id:               w.GetId(),
+		ClientWithdrawId: w.GetClientCrossWithdrawId(),
+		CoinId:           w.GetCoinId(),
+		Amount:           w.GetAmount(),
+		Fee:              w.GetFee(),
+		EthAddress:       w.GetEthAddress(),
+		Erc20Address:     w.GetErc20Address(),
+		ChainId:          w.GetChainId(),
+		Status:           w.GetStatus(),
+		State:            StateOf(w.GetStatus()),
+		FailureReason:    internal.FirstNonEmpty(w.GetCensorFailReason(), w.GetL2RejectReason(), w.GetL1RejectedReasonMsg()),
+		CreatedTime:      internal.ParseMillis(w.GetCreatedTime()),
+		UpdatedTime:      internal.ParseMillis(w.GetUpdatedTime()),
+	}
+}
@@ -0,0 +1,292 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+
+	openapi "github.com/edgex-Tech/edgex-golang-sdk/openapi"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/order"
+)
+
+// AccountEventSnapshot is the event of the account snapshot sent on each private connection
+const AccountEventSnapshot = "Snapshot"
+
+// maxPendingAccountEvents bounds the updates held back until the snapshot arrives
+const maxPendingAccountEvents = 1024
+
+// maxFinalOrders bounds the final orders kept for WaitForOrderFinal
+const maxFinalOrders = 1024
+
+// AccountUpdate is an update of one kind of the private account stream
+type AccountUpdate[T any] struct {
+	Event    string // Event that caused the update, e.g. Snapshot or ORDER_UPDATE
+	Version  string // Version of the account after the update
+	Snapshot bool   // Data is the full state of this kind rather than the changed items
+	Data     []T
+}
+
+// Typed updates of the private account stream
+type (
+	OrderUpdate                 = AccountUpdate[openapi.Order]
+	OrderFillUpdate             = AccountUpdate[openapi.OrderFillTransaction]
+	PositionUpdate              = AccountUpdate[openapi.Position]
+	CollateralUpdate            = AccountUpdate[openapi.Collateral]
+	PositionTransactionUpdate   = AccountUpdate[openapi.PositionTransaction]
+	CollateralTransactionUpdate = AccountUpdate[openapi.CollateralTransaction]
+)
+
+// accountEvent is a message of the private account stream
+type accountEvent struct {
+	Type    string `json:"type"`
+	Content struct {
+		Event   string      `json:"event"`
+		Version string      `json:"version"`
+		Data    accountData `json:"data"`
+	} `json:"content"`
+}
+
+type accountData struct {
+	Order                 []openapi.Order                 `json:"order"`
+	OrderFillTransaction  []openapi.OrderFillTransaction  `json:"orderFillTransaction"`
+	Position              []openapi.Position              `json:"position"`
+	Collateral            []openapi.Collateral            `json:"collateral"`
+	PositionTransaction   []openapi.PositionTransaction   `json:"positionTransaction"`
+	CollateralTransaction []openapi.CollateralTransaction `json:"collateralTransaction"`
+}
+
+// AccountStream decodes the private account stream into typed updates. On every
+// connection the handlers of each kind receive the snapshot first, followed by the
+// incremental updates with a later version. Updates that arrive before the snapshot
+// are held back until it is delivered. The snapshot is only sent on connect, so the
+// stream must be attached before the client connects.
+type AccountStream struct {
+	mu sync.Mutex
+
+	orderHandlers                 []func(OrderUpdate)
+	orderFillHandlers             []func(OrderFillUpdate)
+	positionHandlers              []func(PositionUpdate)
+	collateralHandlers            []func(CollateralUpdate)
+	positionTransactionHandlers   []func(PositionTransactionUpdate)
+	collateralTransactionHandlers []func(CollateralTransactionUpdate)
+
+	synced  bool           // The snapshot of the current connection was delivered
+	version int64          // Version of the snapshot of the current connection
+	pending []accountEvent // Updates received before the snapshot
+
+	finalOrders   map[string]openapi.Order // Recent orders in a final status by ID
+	finalOrderIds []string                 // IDs of finalOrders, oldest first
+	waiters       map[string][]chan openapi.Order
+}
+
+// NewAccountStream creates an account stream, attach it to a private client with Attach
+func NewAccountStream() *AccountStream {
+	return &AccountStream{
+		finalOrders: make(map[string]openapi.Order),
+		waiters:     make(map[string][]chan openapi.Order),
+	}
+}
+
+// Attach feeds the stream from a private client. It fails if the client is already
+// connected, since the stream would never receive the snapshot of that connection.
+func (s *AccountStream) Attach(client *Client) error {
+	if client.connected() {
+		return fmt.Errorf("account stream must be attached before the private WebSocket connects")
+	}
+	client.OnMessageHook(s.handleMessage)
+	client.OnDisconnect(func(error) {
+		s.reset()
+	})
+	return nil
+}
+
+// OnOrders registers a handler for order updates
+func (s *AccountStream) OnOrders(handler func(OrderUpdate)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.orderHandlers = append(s.orderHandlers, handler)
+}
+
+// OnOrderFills registers a handler for order fill updates
+func (s *AccountStream) OnOrderFills(handler func(OrderFillUpdate)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.orderFillHandlers = append(s.orderFillHandlers, handler)
+}
+
+// OnPositions registers a handler for position updates
+func (s *AccountStream) OnPositions(handler func(PositionUpdate)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.positionHandlers = append(s.positionHandlers, handler)
+}
+
+// OnCollaterals registers a handler for collateral updates
+func (s *AccountStream) OnCollaterals(handler func(CollateralUpdate)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.collateralHandlers = append(s.collateralHandlers, handler)
+}
+
+// OnPositionTransactions registers a handler for position transaction updates
+func (s *AccountStream) OnPositionTransactions(handler func(PositionTransactionUpdate)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.positionTransactionHandlers = append(s.positionTransactionHandlers, handler)
+}
+
+// OnCollateralTransactions registers a handler for collateral transaction updates
+func (s *AccountStream) OnCollateralTransactions(handler func(CollateralTransactionUpdate)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.collateralTransactionHandlers = append(s.collateralTransactionHandlers, handler)
+}
+
+// WaitForOrderFinal waits until an order is reported filled or canceled by the stream
+// and returns it. It implements order.CancelConfirmer, so it can confirm the cancel of
+// ReplaceOrder without polling. An order that reached its final status while the
+// connection was down is not reported, bound the wait with the deadline of ctx.
+func (s *AccountStream) WaitForOrderFinal(ctx context.Context, orderId string) (*openapi.Order, error) {
+	s.mu.Lock()
+	if final, ok := s.finalOrders[orderId]; ok {
+		s.mu.Unlock()
+		return &final, nil
+	}
+	ch := make(chan openapi.Order, 1)
+	s.waiters[orderId] = append(s.waiters[orderId], ch)
+	s.mu.Unlock()
+
+	select {
+	case final := <-ch:
+		return &final, nil
+	case <-ctx.Done():
+		s.mu.Lock()
+		waiters := s.waiters[orderId]
+		for i, waiter := range waiters {
+			if waiter == ch {
+				s.waiters[orderId] = append(waiters[:i], waiters[i+1:]...)
+				break
+			}
+		}
+		if len(s.waiters[orderId]) == 0 {
+			delete(s.waiters, orderId)
+		}
+		s.mu.Unlock()
+		return nil, ctx.Err()
+	}
+}
+
+// reset waits for the snapshot of the next connection
+func (s *AccountStream) reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.synced = false
+	s.pending = nil
+}
+
+// handleMessage decodes a private message. It is called from the read loop of the
+// client, so updates are delivered in the order they were received.
+func (s *AccountStream) handleMessage(message []byte) {
+	var event accountEvent
+	if err := json.Unmarshal(message, &event); err != nil || event.Content.Event == "" {
+		return
+	}
+
+	var events []accountEvent
+	s.mu.Lock()
+	switch {
+	case event.Content.Event == AccountEventSnapshot:
+		s.synced = true
+		s.version = parseVersion(event.Content.Version)
+		events = append(events, event)
+		for _, pending := range s.pending {
+			if version := parseVersion(pending.Content.Version); version == 0 || version > s.version {
+				events = append(events, pending)
+			}
+		}
+		s.pending = nil
+	case !s.synced:
+		if len(s.pending) == maxPendingAccountEvents {
+			s.pending = s.pending[1:]
+		}
+		s.pending = append(s.pending, event)
+	default:
+		events = append(events, event)
+	}
+	s.mu.Unlock()
+
+	for _, event := range events {
+		s.dispatch(event)
+	}
+}
+
+// dispatch delivers an event to the handlers of each kind. A snapshot is delivered to
+// every kind, other events only to the kinds they carry.
+func (s *AccountStream) dispatch(event accountEvent) {
+	snapshot := event.Content.Event == AccountEventSnapshot
+	data := event.Content.Data
+	s.trackOrders(data.Order)
+
+	s.mu.Lock()
+	orderHandlers := s.orderHandlers
+	orderFillHandlers := s.orderFillHandlers
+	positionHandlers := s.positionHandlers
+	collateralHandlers := s.collateralHandlers
+	positionTransactionHandlers := s.positionTransactionHandlers
+	collateralTransactionHandlers := s.collateralTransactionHandlers
+	s.mu.Unlock()
+
+	deliver(event, snapshot, data.Collateral, collateralHandlers)
+	deliver(event, snapshot, data.Position, positionHandlers)
+	deliver(event, snapshot, data.Order, orderHandlers)
+	deliver(event, snapshot, data.OrderFillTransaction, orderFillHandlers)
+	deliver(event, snapshot, data.PositionTransaction, positionTransactionHandlers)
+	deliver(event, snapshot, data.CollateralTransaction, collateralTransactionHandlers)
+}
+
+// deliver calls handlers with the items of one kind of an event
+func deliver[T any](event accountEvent, snapshot bool, data []T, handlers []func(AccountUpdate[T])) {
+	if len(handlers) == 0 || (!snapshot && len(data) == 0) {
+		return
+	}
+	update := AccountUpdate[T]{
+		Event:    event.Content.Event,
+		Version:  event.Content.Version,
+		Snapshot: snapshot,
+		Data:     data,
+	}
+	for _, handler := range handlers {
+		handler(update)
+	}
+}
+
+// trackOrders records orders in a final status and wakes their waiters
+func (s *AccountStream) trackOrders(orders []openapi.Order) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, o := range orders {
+		if !order.OrderStatus(o.GetStatus()).IsFinal() {
+			continue
+		}
+		if _, ok := s.finalOrders[o.GetId()]; !ok {
+			if len(s.finalOrderIds) == maxFinalOrders {
+				delete(s.finalOrders, s.finalOrderIds[0])
+				s.finalOrderIds = s.finalOrderIds[1:]
+			}
+			s.finalOrderIds = append(s.finalOrderIds, o.GetId())
+		}
+		s.finalOrders[o.GetId()] = o
+		for _, waiter := range s.waiters[o.GetId()] {
+			waiter <- o
+		}
+		delete(s.waiters, o.GetId())
+	}
+}
+
+// parseVersion parses an account version, empty or invalid is 0
+func parseVersion(version string) int64 {
+	v, _ := strconv.ParseInt(version, 10, 64)
+	return v
+}
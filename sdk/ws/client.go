@@ -22,14 +22,17 @@ type Client struct {
 	conn              *websocket.Conn
 	url               string
 	mu                sync.RWMutex
-	handlers          map[string]MessageHandler
-	done              chan struct{}
-	pingTicker        *time.Ticker
+	handlers          map[string][]handlerEntry // By full channel or message type
+	nextHandlerID     uint64
+	done              chan struct{} // Closed by Close, recreated by Connect
 	isPrivate         bool
+	subMu             sync.Mutex // Serializes the handlers of subscriptions with their frames
 	subscriptions     map[string]struct{}
 	onConnectHooks    []func()
 	onMessageHooks    []func([]byte)
 	onDisconnectHooks []func(error)
+	onLifecycleHooks  []func(LifecycleEvent)
+	reconnectConfig   *ReconnectConfig
 	accountID         int64
 	starkPriKey       string
 }
@@ -37,6 +40,12 @@ type Client struct {
 // MessageHandler is a function type for handling WebSocket messages
 type MessageHandler func(message []byte)
 
+// handlerEntry is a registered handler, the ID allows removing it
+type handlerEntry struct {
+	id      uint64
+	handler MessageHandler
+}
+
 // Message represents a WebSocket message
 type Message struct {
 	Type string          `json:"type"`
@@ -47,18 +56,77 @@ type Message struct {
 // NewClient creates a new WebSocket client
 func NewClient(url string, isPrivate bool, accountID int64, starkPriKey string) *Client {
 	return &Client{
-		url:           url,
-		handlers:      make(map[string]MessageHandler),
-		done:          make(chan struct{}),
-		isPrivate:     isPrivate,
-		subscriptions: make(map[string]struct{}),
-		accountID:     accountID,
-		starkPriKey:   starkPriKey,
+		url:             url,
+		handlers:        make(map[string][]handlerEntry),
+		isPrivate:       isPrivate,
+		subscriptions:   make(map[string]struct{}),
+		reconnectConfig: DefaultReconnectConfig(),
+		accountID:       accountID,
+		starkPriKey:     starkPriKey,
 	}
 }
 
-// Connect establishes a WebSocket connection
+// SetReconnectConfig sets the automatic reconnect configuration, nil disables reconnecting
+func (c *Client) SetReconnectConfig(cfg *ReconnectConfig) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.reconnectConfig = cfg
+}
+
+// Connect establishes a WebSocket connection. A dropped connection is restored
+// automatically unless reconnecting is disabled with SetReconnectConfig. Topics
+// subscribed before, e.g. by a client that gave up reconnecting, are subscribed again.
 func (c *Client) Connect(ctx context.Context) error {
+	c.mu.RLock()
+	connected := c.done != nil
+	c.mu.RUnlock()
+	if connected {
+		return fmt.Errorf("WebSocket connection is already established")
+	}
+
+	conn, err := c.dial(ctx)
+	if err != nil {
+		return err
+	}
+
+	done := make(chan struct{})
+	c.mu.Lock()
+	if c.done != nil {
+		c.mu.Unlock()
+		conn.Close()
+		return fmt.Errorf("WebSocket connection is already established")
+	}
+	c.conn = conn
+	c.done = done
+	topics := c.topics()
+	c.mu.Unlock()
+
+	c.start(conn, done)
+	c.resubscribe(topics)
+	return nil
+}
+
+// topics returns the subscribed topics, c.mu must be held
+func (c *Client) topics() []string {
+	topics := make([]string, 0, len(c.subscriptions))
+	for topic := range c.subscriptions {
+		topics = append(topics, topic)
+	}
+	return topics
+}
+
+// resubscribe sends the subscribe frames of topics on a new connection
+func (c *Client) resubscribe(topics []string) {
+	for _, topic := range topics {
+		// A failed send means the new connection dropped, which starts another reconnect
+		if err := c.sendMessage(map[string]interface{}{"type": "subscribe", "channel": topic}); err != nil {
+			return
+		}
+	}
+}
+
+// dial opens a new connection, signing the request of private connections
+func (c *Client) dial(ctx context.Context) (*websocket.Conn, error) {
 	dialer := websocket.Dialer{}
 	headers := http.Header{}
 
@@ -70,20 +138,17 @@ func (c *Client) Connect(ctx context.Context) error {
 		// Generate signature content
 		path := fmt.Sprintf("/api/v1/private/wsaccountId=%d", c.accountID)
 		signContent := fmt.Sprintf("%d%s%s", timestamp, "GET", path)
-		fmt.Printf("Signing content: %s\n", signContent)
 
 		// Hash the content
 		hash := sha3.NewLegacyKeccak256()
 		hash.Write([]byte(signContent))
 		messageHash := hash.Sum(nil)
-		fmt.Printf("Message hash: %x\n", messageHash)
 
 		// Decode private key
 		privKeyBytes, err := hex.DecodeString(c.starkPriKey)
 		if err != nil {
-			return fmt.Errorf("failed to decode private key: %w", err)
+			return nil, fmt.Errorf("failed to decode private key: %w", err)
 		}
-		fmt.Printf("Private key bytes: %x\n", privKeyBytes)
 
 		// Convert to big.Int
 		starkPrivKey := big.NewInt(0).SetBytes(privKeyBytes)
@@ -93,7 +158,7 @@ func (c *Client) Connect(ctx context.Context) error {
 		// Sign the message
 		r, s, err := starkcurve.Sign(starkPrivKey.Bytes(), msgHashInt.Bytes())
 		if err != nil {
-			return fmt.Errorf("failed to sign message: %w", err)
+			return nil, fmt.Errorf("failed to sign message: %w", err)
 		}
 
 		// Convert r and s to 32-byte hex strings
@@ -108,42 +173,66 @@ func (c *Client) Connect(ctx context.Context) error {
 
 	conn, _, err := dialer.DialContext(ctx, c.url, headers)
 	if err != nil {
-		return fmt.Errorf("failed to connect to WebSocket: %w", err)
+		return nil, fmt.Errorf("failed to connect to WebSocket: %w", err)
 	}
+	return conn, nil
+}
 
-	c.mu.Lock()
-	c.conn = conn
-	c.mu.Unlock()
-
-	// Start ping ticker
-	c.pingTicker = time.NewTicker(30 * time.Second)
-
-	// Start message handling
-	go c.handleMessages()
-	go c.handlePing()
+// start runs the message and ping loops of a new connection and calls the connect hooks
+func (c *Client) start(conn *websocket.Conn, done chan struct{}) {
+	go c.handleMessages(conn, done)
+	go c.handlePing(conn, done)
 
 	// Call connect hooks
-	for _, hook := range c.onConnectHooks {
+	c.mu.RLock()
+	hooks := c.onConnectHooks
+	c.mu.RUnlock()
+	for _, hook := range hooks {
 		hook()
 	}
+}
 
+// Close closes the WebSocket connection and stops reconnecting. The client can be
+// connected again with Connect.
+func (c *Client) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.done != nil {
+		close(c.done)
+		c.done = nil
+	}
+	if c.conn != nil {
+		err := c.conn.Close()
+		c.conn = nil
+		return err
+	}
 	return nil
 }
 
-// Close closes the WebSocket connection
-func (c *Client) Close() error {
-	close(c.done)
-	if c.pingTicker != nil {
-		c.pingTicker.Stop()
+// shutdown marks the client as disconnected after the connection of done was lost for
+// good, unless the client was closed or connected again in the meantime
+func (c *Client) shutdown(done chan struct{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.done == done {
+		close(done)
+		c.done = nil
 	}
+}
 
+// connected reports whether the client is connected or reconnecting
+func (c *Client) connected() bool {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
+	return c.done != nil
+}
 
-	if c.conn != nil {
-		return c.conn.Close()
-	}
-	return nil
+// reconnecting reports whether the connection is down and being restored
+func (c *Client) reconnecting() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.done != nil && c.conn == nil && c.reconnectConfig != nil
 }
 
 // QuoteEvent represents a quote event message
@@ -157,31 +246,48 @@ type QuoteEvent struct {
 	} `json:"content"`
 }
 
-// handleMessages processes incoming WebSocket messages
-func (c *Client) handleMessages() {
+// handleMessages processes incoming WebSocket messages of conn until it fails,
+// then reconnects unless the client was closed
+func (c *Client) handleMessages(conn *websocket.Conn, done chan struct{}) {
 	for {
 		select {
-		case <-c.done:
+		case <-done:
 			return
 		default:
-			c.mu.RLock()
-			conn := c.conn
-			c.mu.RUnlock()
-
-			if conn == nil {
-				return
-			}
-
 			_, message, err := conn.ReadMessage()
 			if err != nil {
-				for _, hook := range c.onDisconnectHooks {
+				c.mu.RLock()
+				hooks := c.onDisconnectHooks
+				c.mu.RUnlock()
+				for _, hook := range hooks {
 					hook(err)
 				}
+
+				select {
+				case <-done:
+					return
+				default:
+				}
+				conn.Close()
+				c.mu.Lock()
+				if c.conn == conn {
+					c.conn = nil
+				}
+				cfg := c.reconnectConfig
+				c.mu.Unlock()
+				if cfg == nil {
+					c.shutdown(done)
+					return
+				}
+				c.reconnect(done, err)
 				return
 			}
 
 			// Call message hooks
-			for _, hook := range c.onMessageHooks {
+			c.mu.RLock()
+			hooks := c.onMessageHooks
+			c.mu.RUnlock()
+			for _, hook := range hooks {
 				hook(message)
 			}
 
@@ -203,34 +309,53 @@ func (c *Client) handleMessages() {
 					continue
 				}
 
-				// Extract channel type from channel string (e.g., "ticker" from "ticker.10000001")
-				channelType := strings.Split(quoteEvent.Channel, ".")[0]
-				if handler, ok := c.handlers[channelType]; ok {
-					handler(message)
+				channel := quoteEvent.Channel
+				if channel == "" {
+					channel = quoteEvent.Content.Channel
 				}
+				// Handlers of the full channel, then of the channel type (e.g., "ticker" from "ticker.10000001")
+				c.dispatch(message, channel, strings.Split(channel, ".")[0])
 				continue
 			}
 
 			// Call registered handlers for other message types
-			if handler, ok := c.handlers[msg.Type]; ok {
-				handler(message)
-			}
+			c.dispatch(message, msg.Type)
 		}
 	}
 }
 
-// handlePing sends periodic ping messages
-func (c *Client) handlePing() {
+// dispatch calls the handlers registered for each key with message
+func (c *Client) dispatch(message []byte, keys ...string) {
+	var entries []handlerEntry
+	c.mu.RLock()
+	for i, key := range keys {
+		if i > 0 && key == keys[0] {
+			continue
+		}
+		entries = append(entries, c.handlers[key]...)
+	}
+	c.mu.RUnlock()
+
+	for _, entry := range entries {
+		entry.handler(message)
+	}
+}
+
+// handlePing sends periodic ping messages while conn is the current connection
+func (c *Client) handlePing(conn *websocket.Conn, done chan struct{}) {
+	ticker := time.NewTicker(30 * time.Second)
+	defer ticker.Stop()
+
 	for {
 		select {
-		case <-c.done:
+		case <-done:
 			return
-		case <-c.pingTicker.C:
+		case <-ticker.C:
 			c.mu.RLock()
-			conn := c.conn
+			current := c.conn
 			c.mu.RUnlock()
 
-			if conn == nil {
+			if current != conn {
 				return
 			}
 
@@ -256,7 +381,9 @@ func (c *Client) handlePong(timestamp string) {
 	_ = c.sendMessage(pongMsg)
 }
 
-// Subscribe subscribes to a topic (for public WebSocket)
+// Subscribe subscribes to a topic (for public WebSocket). A topic that is already
+// subscribed is not sent again. While the connection is being restored the topic is
+// recorded and subscribed by the reconnect.
 func (c *Client) Subscribe(topic string, params map[string]interface{}) error {
 	if c.isPrivate {
 		return fmt.Errorf("cannot subscribe on private WebSocket connection")
@@ -267,18 +394,30 @@ func (c *Client) Subscribe(topic string, params map[string]interface{}) error {
 		"channel": topic,
 	}
 
-	if err := c.sendMessage(subMsg); err != nil {
-		return err
-	}
-
+	// Record the topic first so that a reconnect in progress replays it
 	c.mu.Lock()
+	if _, ok := c.subscriptions[topic]; ok {
+		c.mu.Unlock()
+		return nil
+	}
 	c.subscriptions[topic] = struct{}{}
 	c.mu.Unlock()
 
+	if err := c.sendMessage(subMsg); err != nil {
+		if c.reconnecting() {
+			return nil
+		}
+		c.mu.Lock()
+		delete(c.subscriptions, topic)
+		c.mu.Unlock()
+		return err
+	}
+
 	return nil
 }
 
-// Unsubscribe unsubscribes from a topic (for public WebSocket)
+// Unsubscribe unsubscribes from a topic and removes its handlers (for public WebSocket).
+// While the connection is being restored the topic is only dropped from the replay.
 func (c *Client) Unsubscribe(topic string) error {
 	if c.isPrivate {
 		return fmt.Errorf("cannot unsubscribe on private WebSocket connection")
@@ -289,22 +428,98 @@ func (c *Client) Unsubscribe(topic string) error {
 		"channel": topic,
 	}
 
-	if err := c.sendMessage(unsubMsg); err != nil {
+	if err := c.sendMessage(unsubMsg); err != nil && !c.reconnecting() {
 		return err
 	}
 
 	c.mu.Lock()
 	delete(c.subscriptions, topic)
+	delete(c.handlers, topic)
 	c.mu.Unlock()
 
 	return nil
 }
 
-// OnMessage registers a handler for a specific message type
-func (c *Client) OnMessage(msgType string, handler MessageHandler) {
+// OnMessage registers a handler for a full channel (e.g., "ticker.10000001"), a channel
+// type (e.g., "ticker") or a message type. Several handlers can be registered for the
+// same key, the handlers of a channel are removed by Unsubscribe.
+func (c *Client) OnMessage(key string, handler MessageHandler) {
+	c.addHandler(key, handler)
+}
+
+// addHandler registers a handler and returns its ID for removeHandler
+func (c *Client) addHandler(key string, handler MessageHandler) uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.nextHandlerID++
+	c.handlers[key] = append(c.handlers[key], handlerEntry{id: c.nextHandlerID, handler: handler})
+	return c.nextHandlerID
+}
+
+// removeHandler removes a handler registered with addHandler and reports whether it
+// was the last handler of key
+func (c *Client) removeHandler(key string, id uint64) bool {
 	c.mu.Lock()
 	defer c.mu.Unlock()
-	c.handlers[msgType] = handler
+	// Copy so that dispatches in progress keep their view of the handlers
+	entries := make([]handlerEntry, 0, len(c.handlers[key]))
+	for _, entry := range c.handlers[key] {
+		if entry.id != id {
+			entries = append(entries, entry)
+		}
+	}
+	if len(entries) == len(c.handlers[key]) {
+		// Already removed, e.g. by Unsubscribe
+		return false
+	}
+	if len(entries) == 0 {
+		delete(c.handlers, key)
+		return true
+	}
+	c.handlers[key] = entries
+	return false
+}
+
+// Subscription is a handler of a channel registered by the Subscribe methods of Manager
+type Subscription struct {
+	client  *Client
+	channel string
+	id      uint64
+}
+
+// Channel returns the channel of the subscription (e.g., "ticker.10000001")
+func (s *Subscription) Channel() string {
+	return s.channel
+}
+
+// Unsubscribe removes the handler of the subscription. The channel is unsubscribed on
+// the server once its last handler is removed.
+func (s *Subscription) Unsubscribe() error {
+	return s.client.unsubscribeHandler(s.channel, s.id)
+}
+
+// subscribeWithHandler registers a handler for a channel and subscribes to it unless
+// it is already subscribed, the handler is removed again if the subscription fails
+func (c *Client) subscribeWithHandler(channel string, handler MessageHandler) (*Subscription, error) {
+	c.subMu.Lock()
+	defer c.subMu.Unlock()
+	id := c.addHandler(channel, handler)
+	if err := c.Subscribe(channel, nil); err != nil {
+		c.removeHandler(channel, id)
+		return nil, err
+	}
+	return &Subscription{client: c, channel: channel, id: id}, nil
+}
+
+// unsubscribeHandler removes a handler of a channel and unsubscribes from the channel
+// if it was the last handler
+func (c *Client) unsubscribeHandler(channel string, id uint64) error {
+	c.subMu.Lock()
+	defer c.subMu.Unlock()
+	if !c.removeHandler(channel, id) {
+		return nil
+	}
+	return c.Unsubscribe(channel)
 }
 
 // OnMessageHook registers a hook that will be called for all messages
@@ -316,14 +531,25 @@ func (c *Client) OnMessageHook(hook MessageHandler) {
 
 // OnConnect registers a hook that will be called when connection is established
 func (c *Client) OnConnect(hook func()) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.onConnectHooks = append(c.onConnectHooks, hook)
 }
 
 // OnDisconnect registers a hook that will be called when connection is closed
 func (c *Client) OnDisconnect(hook func(error)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.onDisconnectHooks = append(c.onDisconnectHooks, hook)
 }
 
+// OnLifecycle registers a hook that will be called on reconnect lifecycle events
+func (c *Client) OnLifecycle(hook func(LifecycleEvent)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.onLifecycleHooks = append(c.onLifecycleHooks, hook)
+}
+
 // sendMessage sends a message through the WebSocket connection
 func (c *Client) sendMessage(msg interface{}) error {
 	c.mu.Lock()
@@ -0,0 +1,98 @@
+package ws
+
+import (
+	"encoding/json"
+
+	openapi "github.com/edgex-Tech/edgex-golang-sdk/openapi"
+)
+
+// DataType tells whether a quote event carries a full snapshot or the changes since
+// the previous event
+type DataType string
+
+const (
+	DataTypeSnapshot DataType = "Snapshot"
+	DataTypeChanged  DataType = "Changed"
+)
+
+// Event is a decoded quote event of a public channel
+type Event[T any] struct {
+	Channel  string // Full channel, e.g. ticker.10000001
+	DataType DataType
+	Data     []T
+}
+
+// Trade represents a public trade of the trades channel
+type Trade struct {
+	TicketId       string `json:"ticketId"`
+	Time           string `json:"time"`
+	Price          string `json:"price"`
+	Size           string `json:"size"`
+	Value          string `json:"value"`
+	TakerOrderId   string `json:"takerOrderId"`
+	MakerOrderId   string `json:"makerOrderId"`
+	TakerAccountId string `json:"takerAccountId"`
+	MakerAccountId string `json:"makerAccountId"`
+	ContractId     string `json:"contractId"`
+	IsBestMatch    bool   `json:"isBestMatch"`
+	IsBuyerMaker   bool   `json:"isBuyerMaker"`
+}
+
+// Typed events of the public channels
+type (
+	TickerEvent = Event[openapi.Ticker]
+	KlineEvent  = Event[openapi.Kline]
+	DepthEvent  = Event[openapi.Depth]
+	TradeEvent  = Event[Trade]
+)
+
+// DecodeEvent decodes a raw quote event message
+func DecodeEvent[T any](message []byte) (Event[T], error) {
+	var quoteEvent QuoteEvent
+	if err := json.Unmarshal(message, &quoteEvent); err != nil {
+		return Event[T]{}, err
+	}
+
+	event := Event[T]{
+		Channel:  quoteEvent.Content.Channel,
+		DataType: DataType(quoteEvent.Content.DataType),
+	}
+	if event.Channel == "" {
+		event.Channel = quoteEvent.Channel
+	}
+	if len(quoteEvent.Content.Data) == 0 {
+		return event, nil
+	}
+
+	// Data is a list, a single object is accepted as a list of one
+	if err := json.Unmarshal(quoteEvent.Content.Data, &event.Data); err != nil {
+		var item T
+		if json.Unmarshal(quoteEvent.Content.Data, &item) != nil {
+			return Event[T]{}, err
+		}
+		event.Data = []T{item}
+	}
+	return event, nil
+}
+
+// typedHandler returns a message handler decoding the events of channel for handler.
+// Messages that fail to decode are skipped.
+func typedHandler[T any](channel string, handler func(Event[T])) MessageHandler {
+	return func(message []byte) {
+		event, err := DecodeEvent[T](message)
+		if err != nil || event.Channel != channel {
+			return
+		}
+		handler(event)
+	}
+}
+
+// ChanHandler returns a handler delivering events to the returned channel, for use with
+// the typed subscriptions. Delivery blocks the connection while the channel is full, so
+// size the buffer for the consumer.
+func ChanHandler[E any](size int) (func(E), <-chan E) {
+	ch := make(chan E, size)
+	return func(event E) {
+		ch <- event
+	}, ch
+}
@@ -14,6 +14,10 @@ type Manager struct {
 	accountID    int64
 	starkPriKey  string
 	mu           sync.RWMutex
+
+	reconnectConfig  *ReconnectConfig
+	onLifecycleHooks []func(LifecycleEvent)
+	accountStream    *AccountStream
 }
 
 // NewManager creates a new WebSocket manager
@@ -22,6 +26,51 @@ func NewManager(baseURL string, accountID int64, starkPriKey string) *Manager {
 		baseURL:     baseURL,
 		accountID:   accountID,
 		starkPriKey: starkPriKey,
+
+		reconnectConfig: DefaultReconnectConfig(),
+	}
+}
+
+// SetReconnectConfig sets the automatic reconnect configuration of connections made
+// after the call, nil disables reconnecting
+func (m *Manager) SetReconnectConfig(cfg *ReconnectConfig) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.reconnectConfig = cfg
+}
+
+// OnLifecycle registers a hook for reconnect lifecycle events of connections made
+// after the call. LifecycleEvent.Private tells the connections apart. After
+// EventGaveUp the connection is dropped, connect again and renew its subscriptions.
+func (m *Manager) OnLifecycle(hook func(LifecycleEvent)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.onLifecycleHooks = append(m.onLifecycleHooks, hook)
+}
+
+// configure applies the reconnect settings of the manager to a new client. A client
+// that gave up reconnecting is dropped, so that the next connect creates a new one.
+func (m *Manager) configure(client *Client) {
+	client.SetReconnectConfig(m.reconnectConfig)
+	client.OnLifecycle(func(event LifecycleEvent) {
+		if event.Type == EventGaveUp {
+			m.drop(client)
+		}
+	})
+	for _, hook := range m.onLifecycleHooks {
+		client.OnLifecycle(hook)
+	}
+}
+
+// drop forgets a client that gave up reconnecting
+func (m *Manager) drop(client *Client) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.publicClient == client {
+		m.publicClient = nil
+	}
+	if m.privateClient == client {
+		m.privateClient = nil
 	}
 }
 
@@ -36,6 +85,7 @@ func (m *Manager) ConnectPublic(ctx context.Context) error {
 
 	url := fmt.Sprintf("%s/api/v1/public/ws", m.baseURL)
 	client := NewClient(url, false, 0, "")  // No auth needed for public
+	m.configure(client)
 	if err := client.Connect(ctx); err != nil {
 		return err
 	}
@@ -55,6 +105,12 @@ func (m *Manager) ConnectPrivate(ctx context.Context) error {
 
 	url := fmt.Sprintf("%s/api/v1/private/ws?accountId=%d", m.baseURL, m.accountID)
 	client := NewClient(url, true, m.accountID, m.starkPriKey)
+	m.configure(client)
+	if m.accountStream != nil {
+		if err := m.accountStream.Attach(client); err != nil {
+			return err
+		}
+	}
 	if err := client.Connect(ctx); err != nil {
 		return err
 	}
@@ -64,49 +120,81 @@ func (m *Manager) ConnectPrivate(ctx context.Context) error {
 }
 
 // SubscribeMarketTicker subscribes to 24-hour market ticker updates
-func (m *Manager) SubscribeMarketTicker(contractID string, handler MessageHandler) error {
+func (m *Manager) SubscribeMarketTicker(contractID string, handler MessageHandler) (*Subscription, error) {
 	m.mu.RLock()
 	client := m.publicClient
 	m.mu.RUnlock()
 
 	if client == nil {
-		return fmt.Errorf("public WebSocket connection not established")
+		return nil, fmt.Errorf("public WebSocket connection not established")
 	}
 
-	client.OnMessage("ticker", handler)
-	return client.Subscribe(fmt.Sprintf("ticker.%s", contractID), nil)
+	return client.subscribeWithHandler(fmt.Sprintf("ticker.%s", contractID), handler)
 }
 
 // SubscribeKLine subscribes to K-line (candlestick) data
-func (m *Manager) SubscribeKLine(contractID string, interval string, handler MessageHandler) error {
+func (m *Manager) SubscribeKLine(contractID string, interval string, handler MessageHandler) (*Subscription, error) {
 	m.mu.RLock()
 	client := m.publicClient
 	m.mu.RUnlock()
 
 	if client == nil {
-		return fmt.Errorf("public WebSocket connection not established")
+		return nil, fmt.Errorf("public WebSocket connection not established")
 	}
 
-	client.OnMessage("kline", handler)
-	return client.Subscribe(fmt.Sprintf("kline.LAST_PRICE.%s.%s", contractID, interval), nil)
+	return client.subscribeWithHandler(fmt.Sprintf("kline.LAST_PRICE.%s.%s", contractID, interval), handler)
 }
 
 // SubscribeDepth subscribes to market depth updates
-func (m *Manager) SubscribeDepth(contractID string, handler MessageHandler) error {
+func (m *Manager) SubscribeDepth(contractID string, handler MessageHandler) (*Subscription, error) {
 	m.mu.RLock()
 	client := m.publicClient
 	m.mu.RUnlock()
 
 	if client == nil {
-		return fmt.Errorf("public WebSocket connection not established")
+		return nil, fmt.Errorf("public WebSocket connection not established")
 	}
 
-	client.OnMessage("depth", handler)
-	return client.Subscribe(fmt.Sprintf("depth.%s.15", contractID), nil)
+	return client.subscribeWithHandler(fmt.Sprintf("depth.%s.15", contractID), handler)
 }
 
 // SubscribeTrades subscribes to latest trades
-func (m *Manager) SubscribeTrades(contractID string, handler MessageHandler) error {
+func (m *Manager) SubscribeTrades(contractID string, handler MessageHandler) (*Subscription, error) {
+	m.mu.RLock()
+	client := m.publicClient
+	m.mu.RUnlock()
+
+	if client == nil {
+		return nil, fmt.Errorf("public WebSocket connection not established")
+	}
+
+	return client.subscribeWithHandler(fmt.Sprintf("trades.%s", contractID), handler)
+}
+
+// SubscribeTickerEvents subscribes to 24-hour market ticker updates decoded into TickerEvent
+func (m *Manager) SubscribeTickerEvents(contractID string, handler func(TickerEvent)) (*Subscription, error) {
+	return m.SubscribeMarketTicker(contractID, typedHandler(fmt.Sprintf("ticker.%s", contractID), handler))
+}
+
+// SubscribeKLineEvents subscribes to K-line (candlestick) data decoded into KlineEvent
+func (m *Manager) SubscribeKLineEvents(contractID string, interval string, handler func(KlineEvent)) (*Subscription, error) {
+	return m.SubscribeKLine(contractID, interval, typedHandler(fmt.Sprintf("kline.LAST_PRICE.%s.%s", contractID, interval), handler))
+}
+
+// SubscribeDepthEvents subscribes to market depth updates decoded into DepthEvent. The
+// first event is a snapshot, later events carry the changed levels.
+func (m *Manager) SubscribeDepthEvents(contractID string, handler func(DepthEvent)) (*Subscription, error) {
+	return m.SubscribeDepth(contractID, typedHandler(fmt.Sprintf("depth.%s.15", contractID), handler))
+}
+
+// SubscribeTradeEvents subscribes to latest trades decoded into TradeEvent
+func (m *Manager) SubscribeTradeEvents(contractID string, handler func(TradeEvent)) (*Subscription, error) {
+	return m.SubscribeTrades(contractID, typedHandler(fmt.Sprintf("trades.%s", contractID), handler))
+}
+
+// Unsubscribe unsubscribes from a public channel (e.g., "ticker.10000001") and removes
+// all of its handlers. Use Subscription.Unsubscribe to remove a single handler.
+func (m *Manager) Unsubscribe(channel string) error {
 	m.mu.RLock()
 	client := m.publicClient
 	m.mu.RUnlock()
@@ -115,8 +203,7 @@ func (m *Manager) SubscribeTrades(contractID string, handler MessageHandler) err
 		return fmt.Errorf("public WebSocket connection not established")
 	}
 
-	client.OnMessage("trades", handler)
-	return client.Subscribe(fmt.Sprintf("trades.%s", contractID), nil)
+	return client.Unsubscribe(channel)
 }
 
 // OnPrivateMessage registers a handler for private WebSocket messages
@@ -133,6 +220,23 @@ func (m *Manager) OnPrivateMessage(msgType string, handler MessageHandler) error
 	return nil
 }
 
+// AccountStream returns the typed account stream of the private connection. The
+// stream is created on the first call, which must happen before ConnectPrivate since
+// the snapshot is only sent on connect. Register its handlers before ConnectPrivate
+// to receive that snapshot.
+func (m *Manager) AccountStream() (*AccountStream, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.accountStream == nil {
+		if m.privateClient != nil {
+			return nil, fmt.Errorf("account stream must be created before ConnectPrivate")
+		}
+		m.accountStream = NewAccountStream()
+	}
+	return m.accountStream, nil
+}
+
 // OnPublicMessage registers a handler for all public WebSocket messages
 func (m *Manager) OnPublicMessage(handler MessageHandler) error {
 	m.mu.RLock()
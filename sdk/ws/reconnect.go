@@ -0,0 +1,118 @@
+package ws
+
+import (
+	"context"
+	"time"
+)
+
+// ReconnectConfig configures automatic reconnection after the connection drops
+type ReconnectConfig struct {
+	InitialBackoff time.Duration // Delay before the first attempt
+	MaxBackoff     time.Duration // Upper bound of the delay between attempts
+	Multiplier     float64       // Growth factor of the delay after each failed attempt
+	MaxAttempts    int           // Attempts before giving up, 0 retries forever
+	DialTimeout    time.Duration // Timeout of each connection attempt
+}
+
+// DefaultReconnectConfig returns the reconnect configuration used by new clients
+func DefaultReconnectConfig() *ReconnectConfig {
+	return &ReconnectConfig{
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     30 * time.Second,
+		Multiplier:     2,
+		DialTimeout:    10 * time.Second,
+	}
+}
+
+// backoff returns the delay before the given attempt, starting at 1
+func (r *ReconnectConfig) backoff(attempt int) time.Duration {
+	delay := float64(r.InitialBackoff)
+	for i := 1; i < attempt; i++ {
+		delay *= r.Multiplier
+		if r.MaxBackoff > 0 && delay >= float64(r.MaxBackoff) {
+			return r.MaxBackoff
+		}
+	}
+	return time.Duration(delay)
+}
+
+// LifecycleEventType identifies a reconnect lifecycle event
+type LifecycleEventType string
+
+const (
+	EventReconnecting LifecycleEventType = "reconnecting" // A reconnect attempt is scheduled
+	EventReconnected  LifecycleEventType = "reconnected"  // The connection is restored and subscriptions replayed
+	EventGaveUp       LifecycleEventType = "gave-up"      // MaxAttempts reconnect attempts failed
+)
+
+// LifecycleEvent reports the progress of automatic reconnection
+type LifecycleEvent struct {
+	Type    LifecycleEventType
+	Private bool          // Whether the event is for a private connection
+	Attempt int           // Reconnect attempt, starting at 1
+	Delay   time.Duration // Delay before the attempt, set for EventReconnecting
+	Err     error         // Error that dropped the connection or failed the last attempt
+}
+
+// reconnect redials until the connection is restored, the client is closed or
+// MaxAttempts is reached. Subscriptions are replayed on the new connection.
+func (c *Client) reconnect(done chan struct{}, cause error) {
+	c.mu.RLock()
+	cfg := c.reconnectConfig
+	c.mu.RUnlock()
+
+	lastErr := cause
+	for attempt := 1; cfg.MaxAttempts == 0 || attempt <= cfg.MaxAttempts; attempt++ {
+		delay := cfg.backoff(attempt)
+		c.emit(LifecycleEvent{Type: EventReconnecting, Attempt: attempt, Delay: delay, Err: lastErr})
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-done:
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), cfg.DialTimeout)
+		conn, err := c.dial(ctx)
+		cancel()
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		// The client may have been closed while dialing
+		c.mu.Lock()
+		select {
+		case <-done:
+			c.mu.Unlock()
+			conn.Close()
+			return
+		default:
+		}
+		c.conn = conn
+		topics := c.topics()
+		c.mu.Unlock()
+
+		c.start(conn, done)
+		c.resubscribe(topics)
+		c.emit(LifecycleEvent{Type: EventReconnected, Attempt: attempt})
+		return
+	}
+
+	// Mark the client as disconnected so that Connect can be called again
+	c.shutdown(done)
+	c.emit(LifecycleEvent{Type: EventGaveUp, Attempt: cfg.MaxAttempts, Err: lastErr})
+}
+
+// emit calls the lifecycle hooks with event
+func (c *Client) emit(event LifecycleEvent) {
+	event.Private = c.isPrivate
+	c.mu.RLock()
+	hooks := c.onLifecycleHooks
+	c.mu.RUnlock()
+	for _, hook := range hooks {
+		hook(event)
+	}
+}
@@ -201,11 +201,9 @@ func TestCreateNormalWithdraw(t *testing.T) {
 		Amount:           "1.000000",
 		EthAddress:       "0x1fB51aa234287C3CA1F957eA9AD0E148Bb814b7A",
 		ClientWithdrawId: "745410645654877",
-		ExpireTime:       "1735887600000",
-		L2Signature:      "007bf80407c6a7bb14f5ca3b848a5d908627993f23b073c902e359a6fa4a6a92040cea4c98e25e35ad1d8cc4e18758c463c45bf451299ce55aa49abbdb916d03",
 	}
 
-	resp, err := client.Asset.CreateNormalWithdraw(ctx, params)
+	resp, err := client.CreateNormalWithdraw(ctx, params)
 	if err != nil {
 		t.Logf("Error creating normal withdraw: %v", err)
 		t.Skip("Skipping test due to error")
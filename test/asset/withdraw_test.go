@@ -0,0 +1,203 @@
+package asset
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/asset"
+	"github.com/edgex-Tech/edgex-golang-sdk/starkcurve"
+	"github.com/edgex-Tech/edgex-golang-sdk/test"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/sha3"
+)
+
+const testMetaData = `{"code":"SUCCESS","data":{
+	"global":{"starkExCollateralCoin":{"coinId":"1000","starkExAssetId":"0x2"}},
+	"coinList":[{"coinId":"1000","starkExAssetId":"0x2"}],
+	"multiChain":{"coinId":"1000","chainList":[{"chainId":"1","tokenList":[
+		{"tokenAddress":"0xdAC17F958D2ee523a2206206994597C13D831ec7","decimals":"6","withdrawEnable":true}]}]}}}`
+
+const testFastWithdrawSignInfo = `{"code":"SUCCESS","data":{
+	"lpAccountId":"20000",
+	"fastWithdrawL2Key":"0x3a1b2c",
+	"fastWithdrawFactRegisterAddress":"0xBE9a129909EbCb954bC065536D2bfAfBd170d27A",
+	"fastWithdrawMaxAmount":"100",
+	"fee":"0.5"}}`
+
+const testEthAddress = "0x1fB51aa234287C3CA1F957eA9AD0E148Bb814b7A"
+
+func TestCreateNormalWithdrawSignature(t *testing.T) {
+	var param map[string]string
+	server := test.NewMockServer(t, testMetaData, map[string]http.HandlerFunc{
+		"/api/v1/private/assets/createNormalWithdraw": func(w http.ResponseWriter, r *http.Request) {
+			json.NewDecoder(r.Body).Decode(&param)
+			w.Write([]byte(`{"code":"SUCCESS","data":{"normalWithdrawId":"1"}}`))
+		},
+	})
+	defer server.Close()
+	client := test.NewMockClient(t, &sdk.ClientConfig{BaseURL: server.URL})
+
+	resp, err := client.CreateNormalWithdraw(context.Background(), asset.CreateNormalWithdrawParams{
+		CoinId:      "1000",
+		Amount:      "1.5",
+		EthAddress:  testEthAddress,
+		ExpireAfter: 24 * time.Hour,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "SUCCESS", resp.GetCode())
+
+	assert.Equal(t, "12345", param["accountId"])
+	assert.Equal(t, "1.5", param["amount"])
+	assert.NotEmpty(t, param["clientWithdrawId"])
+	expireTime, err := strconv.ParseInt(param["expireTime"], 10, 64)
+	assert.NoError(t, err)
+	assert.InDelta(t, time.Now().Add(24*time.Hour).UnixMilli(), expireTime, float64(time.Minute.Milliseconds()))
+
+	// Rebuild the withdrawal to address message of the StarkEx perpetual spec
+	ethAddress, _ := new(big.Int).SetString(testEthAddress[2:], 16)
+	packed := big.NewInt(7)
+	for _, field := range []struct {
+		bits  uint
+		value *big.Int
+	}{
+		{64, big.NewInt(12345)},
+		{32, test.L2Nonce(param["clientWithdrawId"])},
+		{64, big.NewInt(1500000)},
+		{32, big.NewInt(expireTime / 3600000)},
+	} {
+		packed.Lsh(packed, field.bits).Add(packed, field.value)
+	}
+	packed.Lsh(packed, 49)
+	msg := starkcurve.CalcHash([]*big.Int{big.NewInt(2), ethAddress})
+	msg = starkcurve.CalcHash([]*big.Int{new(big.Int).SetBytes(msg), packed})
+	test.VerifyL2Signature(t, msg, param["l2Signature"])
+}
+
+func TestFastWithdraw(t *testing.T) {
+	var param map[string]string
+	server := test.NewMockServer(t, testMetaData, map[string]http.HandlerFunc{
+		"/api/v1/private/assets/getFastWithdrawSignInfo": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "1", r.URL.Query().Get("chainId"))
+			assert.Equal(t, "10", r.URL.Query().Get("amount"))
+			w.Write([]byte(testFastWithdrawSignInfo))
+		},
+		"/api/v1/private/assets/createFastWithdraw": func(w http.ResponseWriter, r *http.Request) {
+			json.NewDecoder(r.Body).Decode(&param)
+			w.Write([]byte(`{"code":"SUCCESS","data":{"fastWithdrawId":"7"}}`))
+		},
+	})
+	defer server.Close()
+	client := test.NewMockClient(t, &sdk.ClientConfig{BaseURL: server.URL})
+
+	result, err := client.FastWithdraw(context.Background(), asset.WithdrawParams{
+		CoinId:     "1000",
+		Amount:     "10",
+		EthAddress: testEthAddress,
+		ChainId:    "1",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "7", result.WithdrawId)
+	assert.Equal(t, "20000", result.LpAccountId)
+	assert.Equal(t, "0.5", result.Fee)
+
+	assert.Equal(t, "10", param["amount"])
+	assert.Equal(t, "0.5", param["fee"])
+	assert.Equal(t, "20000", param["lpAccountId"])
+	assert.Equal(t, "0xdAC17F958D2ee523a2206206994597C13D831ec7", param["erc20Address"])
+	assert.Equal(t, strconv.FormatInt(result.L2ExpireTime, 10), param["expireTime"])
+	nonce := test.L2Nonce(param["clientFastWithdrawId"])
+	assert.Equal(t, nonce.Int64(), result.L2Nonce)
+
+	// Rebuild the fact of the L1 transfer, keccak256(recipient, amount, token, salt)
+	hexBytes := func(s string) []byte {
+		b, _ := hex.DecodeString(s[2:])
+		return b
+	}
+	keccak := sha3.NewLegacyKeccak256()
+	keccak.Write(hexBytes(testEthAddress))
+	keccak.Write(big.NewInt(10000000).FillBytes(make([]byte, 32)))
+	keccak.Write(hexBytes(param["erc20Address"]))
+	keccak.Write(nonce.FillBytes(make([]byte, 32)))
+	fact := keccak.Sum(nil)
+	assert.Equal(t, "0x"+hex.EncodeToString(fact), param["fact"])
+
+	keccak = sha3.NewLegacyKeccak256()
+	keccak.Write(hexBytes(param["factRegistryAddress"]))
+	keccak.Write(fact)
+	condition := new(big.Int).SetBytes(keccak.Sum(nil))
+	condition.And(condition, new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 250), big.NewInt(1)))
+
+	// Rebuild the conditional transfer of amount plus fee to the provider
+	msg := starkcurve.CalcHash([]*big.Int{big.NewInt(2), big.NewInt(0)})
+	msg = starkcurve.CalcHash([]*big.Int{new(big.Int).SetBytes(msg), big.NewInt(0x3a1b2c)})
+	msg = starkcurve.CalcHash([]*big.Int{new(big.Int).SetBytes(msg), condition})
+	packed0 := test.PackFields(12345, [2]int64{64, 20000}, [2]int64{64, 12345}, [2]int64{32, nonce.Int64()})
+	msg = starkcurve.CalcHash([]*big.Int{new(big.Int).SetBytes(msg), packed0})
+	packed1 := test.PackFields(5, [2]int64{64, 10500000}, [2]int64{64, 0}, [2]int64{32, result.L2ExpireTime / 3600000})
+	packed1.Lsh(packed1, 81)
+	msg = starkcurve.CalcHash([]*big.Int{new(big.Int).SetBytes(msg), packed1})
+	test.VerifyL2Signature(t, msg, param["l2Signature"])
+}
+
+func TestFastWithdrawAmountExceeded(t *testing.T) {
+	server := test.NewMockServer(t, testMetaData, map[string]http.HandlerFunc{
+		"/api/v1/private/assets/getFastWithdrawSignInfo": func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(testFastWithdrawSignInfo))
+		},
+	})
+	defer server.Close()
+	client := test.NewMockClient(t, &sdk.ClientConfig{BaseURL: server.URL})
+
+	_, err := client.FastWithdraw(context.Background(), asset.WithdrawParams{
+		CoinId:     "1000",
+		Amount:     "150",
+		EthAddress: testEthAddress,
+		ChainId:    "1",
+	})
+	assert.True(t, errors.Is(err, sdk.ErrWithdrawAmountExceeded))
+}
+
+func TestWaitForFastWithdraw(t *testing.T) {
+	states := []string{"FAST_WITHDRAW_PENDING_CHECKING", "FAST_WITHDRAW_PENDING_CHECKING", "FAST_WITHDRAW_SUCCESS"}
+	var calls int32
+	server := test.NewMockServer(t, testMetaData, map[string]http.HandlerFunc{
+		"/api/v1/private/assets/getFastWithdrawById": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "7", r.URL.Query().Get("fastWithdrawIdList"))
+			i := int(atomic.AddInt32(&calls, 1)) - 1
+			if i >= len(states) {
+				i = len(states) - 1
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"code": "SUCCESS",
+				"data": []map[string]string{{"id": "7", "status": states[i]}},
+			})
+		},
+	})
+	defer server.Close()
+	client := test.NewMockClient(t, &sdk.ClientConfig{BaseURL: server.URL})
+
+	var statuses []string
+	result, err := client.WaitForFastWithdraw(context.Background(), "7", &asset.WaitForWithdrawParams{
+		PollInterval: 10 * time.Millisecond,
+		OnStatus: func(status string) {
+			statuses = append(statuses, status)
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "FAST_WITHDRAW_SUCCESS", result.GetStatus())
+	assert.Equal(t, []string{"FAST_WITHDRAW_PENDING_CHECKING", "FAST_WITHDRAW_SUCCESS"}, statuses)
+
+	states = []string{"FAST_WITHDRAW_FAILED_L2_REJECT"}
+	atomic.StoreInt32(&calls, 0)
+	_, err = client.WaitForFastWithdraw(context.Background(), "7", &asset.WaitForWithdrawParams{PollInterval: 10 * time.Millisecond})
+	assert.True(t, errors.Is(err, sdk.ErrWithdrawFailed))
+}
@@ -0,0 +1,278 @@
+package batch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/order"
+	"github.com/edgex-Tech/edgex-golang-sdk/test"
+	"github.com/stretchr/testify/assert"
+)
+
+const testMetaData = `{"code":"SUCCESS","data":{
+	"global":{"starkExCollateralCoin":{"coinId":"1000","starkExAssetId":"0x2"}},
+	"contractList":[{"contractId":"10000001","contractName":"BTCUSDT","tickSize":"0.1","stepSize":"0.001",
+		"defaultTakerFeeRate":"0.0005","defaultMakerFeeRate":"0.0002",
+		"starkExSyntheticAssetId":"0x1","starkExResolution":"0x2540be400"}]}}`
+
+func newMockServer(t *testing.T, createOrder http.HandlerFunc) *httptest.Server {
+	var canceled int32
+	return test.NewMockServer(t, testMetaData, map[string]http.HandlerFunc{
+		"/api/v1/private/account/getAccountById": test.JSONResponse(`{"code":"SUCCESS","data":{"id":"12345"}}`),
+		"/api/v1/private/order/createOrder":      createOrder,
+		"/api/v1/private/order/cancelOrderById": func(w http.ResponseWriter, r *http.Request) {
+			var param map[string][]string
+			json.NewDecoder(r.Body).Decode(&param)
+			result := map[string]string{}
+			for _, id := range param["orderIdList"] {
+				result[id] = "SUCCESS"
+			}
+			atomic.StoreInt32(&canceled, 1)
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"code": "SUCCESS",
+				"data": map[string]interface{}{"cancelResultMap": result},
+			})
+		},
+		"/api/v1/private/order/getOrderById": func(w http.ResponseWriter, r *http.Request) {
+			// The order is partially filled again while the cancel is in flight
+			if atomic.LoadInt32(&canceled) == 0 {
+				w.Write([]byte(`{"code":"SUCCESS","data":[{"id":"1","contractId":"10000001","side":"BUY","type":"LIMIT","timeInForce":"GOOD_TIL_CANCEL","price":"30000","size":"1","cumFillSize":"0.3","status":"OPEN"}]}`))
+				return
+			}
+			w.Write([]byte(`{"code":"SUCCESS","data":[{"id":"1","contractId":"10000001","side":"BUY","type":"LIMIT","timeInForce":"GOOD_TIL_CANCEL","price":"30000","size":"1","cumFillSize":"0.4","status":"CANCELED"}]}`))
+		},
+	})
+}
+
+func TestCreateOrders(t *testing.T) {
+	var created int32
+	server := newMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var param map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&param)
+		if param["size"] == "2" {
+			w.Write([]byte(`{"code":"MARGIN_NOT_ENOUGH","errorParam":{}}`))
+			return
+		}
+		id := atomic.AddInt32(&created, 1)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"code": "SUCCESS",
+			"data": map[string]string{"orderId": string(rune('0' + id))},
+		})
+	})
+	defer server.Close()
+
+	client := test.NewMockClient(t, &sdk.ClientConfig{BaseURL: server.URL, BatchConcurrency: 2})
+
+	params := []order.CreateOrderParams{}
+	for _, size := range []string{"1", "2", "1", "1"} {
+		params = append(params, order.CreateOrderParams{
+			ContractId:     "10000001",
+			Price:          "30000",
+			Size:           size,
+			Type:           order.OrderTypeLimit,
+			Side:           order.OrderSideBuy,
+			SkipValidation: true,
+		})
+	}
+
+	results, err := client.CreateOrders(test.GetTestContext(), params)
+	assert.ErrorIs(t, err, sdk.ErrInsufficientMargin)
+	assert.Len(t, results, 4)
+	for i, result := range results {
+		if i == 1 {
+			assert.ErrorIs(t, result.Err, sdk.ErrInsufficientMargin)
+			assert.Nil(t, result.Result)
+			continue
+		}
+		assert.NoError(t, result.Err)
+		assert.NotEmpty(t, result.Result.Data.GetOrderId())
+		assert.Equal(t, "GOOD_TIL_CANCEL", result.Params.TimeInForce)
+	}
+	assert.Equal(t, int32(3), atomic.LoadInt32(&created))
+}
+
+func TestCreateOrdersWithoutTradeSettings(t *testing.T) {
+	var lookups int32
+	server := test.NewMockServer(t, testMetaData, map[string]http.HandlerFunc{
+		"/api/v1/private/account/getAccountById": func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&lookups, 1)
+			w.Write([]byte(`{"code":"INTERNAL_ERROR","errorParam":{}}`))
+		},
+		"/api/v1/private/order/createOrder": test.JSONResponse(`{"code":"SUCCESS","data":{"orderId":"1"}}`),
+	})
+	defer server.Close()
+	var reported int32
+	client := test.NewMockClient(t, &sdk.ClientConfig{
+		BaseURL:          server.URL,
+		BatchConcurrency: 4,
+		OnFeeRateError:   func(err error) { atomic.AddInt32(&reported, 1) },
+	})
+
+	params := make([]order.CreateOrderParams, 4)
+	for i := range params {
+		params[i] = order.CreateOrderParams{
+			ContractId:     "10000001",
+			Price:          "30000",
+			Size:           "1",
+			Type:           order.OrderTypeLimit,
+			Side:           order.OrderSideBuy,
+			SkipValidation: true,
+		}
+	}
+
+	// The orders are signed with the contract default fee rates
+	_, err := client.CreateOrders(test.GetTestContext(), params)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&lookups))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&reported))
+
+	// Asked directly, the fee rates are not silently replaced by the defaults
+	_, err = client.GetFeeRates(test.GetTestContext(), "10000001")
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&lookups))
+}
+
+func TestCanceledTradeSettingsFetchIsNotRecorded(t *testing.T) {
+	var lookups int32
+	server := test.NewMockServer(t, testMetaData, map[string]http.HandlerFunc{
+		"/api/v1/private/account/getAccountById": func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&lookups, 1)
+			w.Write([]byte(`{"code":"SUCCESS","data":{"id":"12345"}}`))
+		},
+		"/api/v1/private/order/createOrder": test.JSONResponse(`{"code":"SUCCESS","data":{"orderId":"1"}}`),
+	})
+	defer server.Close()
+	var reported int32
+	client := test.NewMockClient(t, &sdk.ClientConfig{
+		BaseURL:        server.URL,
+		OnFeeRateError: func(err error) { atomic.AddInt32(&reported, 1) },
+	})
+	_, err := client.MetadataCache.Get(test.GetTestContext())
+	assert.NoError(t, err)
+
+	params := &order.CreateOrderParams{
+		ContractId:     "10000001",
+		Price:          "30000",
+		Size:           "1",
+		Type:           order.OrderTypeLimit,
+		Side:           order.OrderSideBuy,
+		SkipValidation: true,
+	}
+	ctx, cancel := context.WithCancel(test.GetTestContext())
+	cancel()
+	_, err = client.CreateOrder(ctx, params)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	// The next order fetches the trade settings instead of using the defaults
+	_, err = client.CreateOrder(test.GetTestContext(), params)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&lookups))
+	assert.Equal(t, int32(0), atomic.LoadInt32(&reported))
+}
+
+func TestCreateOrderKeepsParams(t *testing.T) {
+	var prices []interface{}
+	server := newMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var param map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&param)
+		prices = append(prices, param["triggerPrice"])
+		w.Write([]byte(`{"code":"SUCCESS","data":{"orderId":"1"}}`))
+	})
+	defer server.Close()
+	client := test.NewMockClient(t, &sdk.ClientConfig{BaseURL: server.URL})
+
+	params := &order.CreateOrderParams{
+		ContractId:     "10000001",
+		Size:           "1",
+		Type:           order.OrderTypeStopMarket,
+		Side:           order.OrderSideSell,
+		TriggerPrice:   "29000",
+		SkipValidation: true,
+	}
+	_, err := client.CreateOrder(test.GetTestContext(), params)
+	assert.NoError(t, err)
+
+	// The worst price derived from the first trigger price is not kept
+	params.TriggerPrice = "28000"
+	_, err = client.CreateOrder(test.GetTestContext(), params)
+	assert.NoError(t, err)
+	assert.Empty(t, params.Price)
+	assert.Empty(t, params.TimeInForce)
+	assert.Empty(t, params.TriggerPriceType)
+	assert.Equal(t, []interface{}{"29000", "28000"}, prices)
+}
+
+func TestCancelOrders(t *testing.T) {
+	server := test.NewMockServer(t, testMetaData, map[string]http.HandlerFunc{
+		"/api/v1/private/order/cancelOrderById": func(w http.ResponseWriter, r *http.Request) {
+			var param map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&param)
+			assert.Len(t, param["orderIdList"], 2)
+			w.Write([]byte(`{"code":"SUCCESS","data":{"cancelResultMap":{"1":"SUCCESS","2":"SUCCESS"}}}`))
+		},
+	})
+	defer server.Close()
+
+	client := test.NewMockClient(t, &sdk.ClientConfig{BaseURL: server.URL})
+
+	result, err := client.CancelOrders(test.GetTestContext(), &order.CancelOrdersParams{
+		OrderIds: []string{"1", "2"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "SUCCESS", result.ByOrderId["1"])
+	assert.Equal(t, "SUCCESS", result.ByOrderId["2"])
+	assert.Nil(t, result.ByClientOrderId)
+}
+
+func TestReplaceOrder(t *testing.T) {
+	var newOrder map[string]interface{}
+	server := newMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&newOrder)
+		w.Write([]byte(`{"code":"SUCCESS","data":{"orderId":"2"}}`))
+	})
+	defer server.Close()
+
+	client := test.NewMockClient(t, &sdk.ClientConfig{BaseURL: server.URL})
+
+	result, err := client.ReplaceOrder(test.GetTestContext(), &order.ReplaceOrderParams{
+		OrderId:       "1",
+		Price:         "30100",
+		WaitForCancel: true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "SUCCESS", result.Cancel.ByOrderId["1"])
+	assert.Equal(t, "CANCELED", result.CanceledOrder.GetStatus())
+	assert.Equal(t, "2", result.Order.Data.GetOrderId())
+
+	// The new order keeps the side and shrinks by the filled size
+	assert.Equal(t, "30100", newOrder["price"])
+	assert.Equal(t, "0.6", newOrder["size"])
+	assert.Equal(t, "BUY", newOrder["side"])
+
+	// A cancel rejected by the server does not place the replacement
+	var created int32
+	server = test.NewMockServer(t, testMetaData, map[string]http.HandlerFunc{
+		"/api/v1/private/order/getOrderById":    test.JSONResponse(`{"code":"SUCCESS","data":[{"id":"1","contractId":"10000001","side":"BUY","type":"LIMIT","timeInForce":"GOOD_TIL_CANCEL","price":"30000","size":"1","status":"OPEN"}]}`),
+		"/api/v1/private/order/cancelOrderById": test.JSONResponse(`{"code":"SUCCESS","data":{"cancelResultMap":{"1":"ORDER_NOT_FOUND"}}}`),
+		"/api/v1/private/order/createOrder": func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&created, 1)
+			w.Write([]byte(`{"code":"SUCCESS","data":{"orderId":"2"}}`))
+		},
+	})
+	defer server.Close()
+	client = test.NewMockClient(t, &sdk.ClientConfig{BaseURL: server.URL})
+
+	result, err = client.ReplaceOrder(test.GetTestContext(), &order.ReplaceOrderParams{
+		OrderId: "1",
+		Price:   "30100",
+	})
+	assert.ErrorIs(t, err, sdk.ErrOrderNotFound)
+	assert.Equal(t, "ORDER_NOT_FOUND", result.Cancel.ByOrderId["1"])
+	assert.Nil(t, result.Order)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&created))
+}
@@ -0,0 +1,113 @@
+package deadman
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/edgex-Tech/edgex-golang-sdk/openapi"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/order"
+	"github.com/edgex-Tech/edgex-golang-sdk/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// newMockServer serves cancelAllOrder and counts the cancels
+func newMockServer(t *testing.T, cancels *int32) *httptest.Server {
+	return test.NewMockServer(t, "", map[string]http.HandlerFunc{
+		"/api/v1/private/order/cancelAllOrder": func(w http.ResponseWriter, r *http.Request) {
+			var param map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&param)
+			assert.Nil(t, param["filterContractIdList"])
+			atomic.AddInt32(cancels, 1)
+			w.Write([]byte(`{"code":"SUCCESS","data":{"cancelResultMap":{"1":"SUCCESS"}}}`))
+		},
+	})
+}
+
+func TestDeadManSwitch(t *testing.T) {
+	var cancels int32
+	server := newMockServer(t, &cancels)
+	defer server.Close()
+
+	client := test.NewMockClient(t, &sdk.ClientConfig{BaseURL: server.URL})
+
+	reasons := make(chan string, 4)
+	d := client.StartDeadManSwitch(order.DeadManSwitchConfig{
+		HeartbeatTimeout: 100 * time.Millisecond,
+		CheckInterval:    10 * time.Millisecond,
+		OnTrigger: func(reason string, result *openapi.ResultCancelOrder, err error) {
+			assert.NoError(t, err)
+			reasons <- reason
+		},
+	}, nil)
+	defer d.Stop()
+
+	// Heartbeats keep the switch from firing
+	for i := 0; i < 5; i++ {
+		time.Sleep(40 * time.Millisecond)
+		d.Heartbeat()
+	}
+	assert.Len(t, reasons, 0)
+
+	// Missing heartbeats cancel all orders once
+	select {
+	case reason := <-reasons:
+		assert.Equal(t, order.DeadManReasonHeartbeat, reason)
+	case <-time.After(time.Second):
+		t.Fatal("dead man's switch did not fire")
+	}
+	time.Sleep(200 * time.Millisecond)
+	assert.Len(t, reasons, 0)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&cancels))
+}
+
+func TestDeadManSwitchHeartbeatDuringOutage(t *testing.T) {
+	var cancels int32
+	server := newMockServer(t, &cancels)
+	defer server.Close()
+
+	client := test.NewMockClient(t, &sdk.ClientConfig{BaseURL: server.URL})
+
+	reasons := make(chan string, 4)
+	d := client.StartDeadManSwitch(order.DeadManSwitchConfig{
+		HeartbeatTimeout:  time.Second,
+		DisconnectTimeout: 50 * time.Millisecond,
+		CheckInterval:     10 * time.Millisecond,
+		OnTrigger: func(reason string, result *openapi.ResultCancelOrder, err error) {
+			assert.NoError(t, err)
+			reasons <- reason
+		},
+	}, nil)
+	defer d.Stop()
+
+	// Heartbeats keep arriving while the connection stays down
+	d.SetConnected(false)
+	for i := 0; i < 10; i++ {
+		time.Sleep(30 * time.Millisecond)
+		d.Heartbeat()
+	}
+
+	select {
+	case reason := <-reasons:
+		assert.Equal(t, order.DeadManReasonDisconnect, reason)
+	case <-time.After(time.Second):
+		t.Fatal("dead man's switch did not fire")
+	}
+	assert.Len(t, reasons, 0)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&cancels))
+
+	// A reconnect re-arms the disconnect timeout
+	d.SetConnected(true)
+	d.SetConnected(false)
+	select {
+	case reason := <-reasons:
+		assert.Equal(t, order.DeadManReasonDisconnect, reason)
+	case <-time.After(time.Second):
+		t.Fatal("dead man's switch did not fire after the reconnect")
+	}
+	assert.Equal(t, int32(2), atomic.LoadInt32(&cancels))
+}
@@ -0,0 +1,105 @@
+package deposit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/edgex-Tech/edgex-golang-sdk/openapi"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/deposit"
+	"github.com/edgex-Tech/edgex-golang-sdk/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// newMockServer serves a deposit that moves through the given states, one per request
+func newMockServer(t *testing.T, states []map[string]string) *httptest.Server {
+	var calls int32
+	return test.NewMockServer(t, "", map[string]http.HandlerFunc{
+		"/api/v1/private/deposit/createDeposit": func(w http.ResponseWriter, r *http.Request) {
+			var param map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&param)
+			assert.Equal(t, "12345", param["accountId"])
+			assert.NotEmpty(t, param["clientDepositId"])
+			w.Write([]byte(`{"code":"SUCCESS","data":{"depositId":"1"}}`))
+		},
+		"/api/v1/private/deposit/getDepositById": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "1", r.URL.Query().Get("depositIdList"))
+			i := int(atomic.AddInt32(&calls, 1)) - 1
+			if i >= len(states) {
+				i = len(states) - 1
+			}
+			state := map[string]string{"id": "1"}
+			for k, v := range states[i] {
+				state[k] = v
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"code": "SUCCESS", "data": []map[string]string{state}})
+		},
+	})
+}
+
+func TestWaitForDeposit(t *testing.T) {
+	server := newMockServer(t, []map[string]string{
+		{"status": "PENDING_CENSORING", "createdTime": "1700000000000"},
+		{"status": "PENDING_CENSORING", "createdTime": "1700000000000"},
+		{"status": "SUCCESS_CENSOR_SUCCESS", "createdTime": "1700000000000", "censorTime": "1700000001000"},
+		{"status": "SUCCESS_L2_APPROVED", "createdTime": "1700000000000", "censorTime": "1700000001000", "l2ApprovedTime": "1700000060000"},
+	})
+	defer server.Close()
+	client := test.NewMockClient(t, &sdk.ClientConfig{BaseURL: server.URL})
+
+	created, err := client.CreateDeposit(context.Background(), deposit.CreateDepositParams{CoinId: "1000", Amount: "10"})
+	assert.NoError(t, err)
+	data := created.GetData()
+	assert.Equal(t, "1", data.GetDepositId())
+
+	var statuses []string
+	result, err := client.WaitForDeposit(context.Background(), data.GetDepositId(), &deposit.WaitForDepositParams{
+		PollInterval: 10 * time.Millisecond,
+		OnUpdate: func(d *openapi.Deposit) {
+			statuses = append(statuses, d.GetStatus())
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"PENDING_CENSORING", "SUCCESS_CENSOR_SUCCESS", "SUCCESS_L2_APPROVED"}, statuses)
+
+	timeline := deposit.Timeline(result)
+	assert.Equal(t, time.Minute, timeline.L2Approved.Sub(timeline.Created))
+	assert.True(t, timeline.L2Rejected.IsZero())
+}
+
+func TestWaitForDepositUntilCredited(t *testing.T) {
+	server := newMockServer(t, []map[string]string{
+		{"status": "PENDING_CENSORING"},
+		{"status": "SUCCESS_CENSOR_SUCCESS"},
+		{"status": "SUCCESS_L2_APPROVED"},
+	})
+	defer server.Close()
+	client := test.NewMockClient(t, &sdk.ClientConfig{BaseURL: server.URL})
+
+	result, err := client.WaitForDeposit(context.Background(), "1", &deposit.WaitForDepositParams{
+		PollInterval:  10 * time.Millisecond,
+		UntilCredited: true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "SUCCESS_CENSOR_SUCCESS", result.GetStatus())
+}
+
+func TestWaitForDepositRejected(t *testing.T) {
+	server := newMockServer(t, []map[string]string{
+		{"status": "PENDING_CENSORING"},
+		{"status": "FAILED_CENSOR_FAILURE", "censorFailCode": "RISK", "censorFailReason": "blocked address"},
+	})
+	defer server.Close()
+	client := test.NewMockClient(t, &sdk.ClientConfig{BaseURL: server.URL})
+
+	result, err := client.WaitForDeposit(context.Background(), "1", &deposit.WaitForDepositParams{PollInterval: 10 * time.Millisecond})
+	assert.True(t, errors.Is(err, sdk.ErrDepositFailed))
+	assert.Contains(t, err.Error(), "blocked address")
+	assert.Equal(t, "FAILED_CENSOR_FAILURE", result.GetStatus())
+}
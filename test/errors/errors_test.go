@@ -0,0 +1,38 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIErrorClassification(t *testing.T) {
+	testCases := []struct {
+		name     string
+		apiErr   *sdk.APIError
+		sentinel error
+	}{
+		{"Insufficient Margin", &sdk.APIError{Code: "MARGIN_NOT_ENOUGH"}, sdk.ErrInsufficientMargin},
+		{"Order Not Found", &sdk.APIError{Code: "FAILED_ORDER_NOT_FOUND"}, sdk.ErrOrderNotFound},
+		{"Rate Limited By Status", &sdk.APIError{HTTPStatus: 429}, sdk.ErrRateLimited},
+		{"Signature Invalid", &sdk.APIError{Code: "INVALID_L2_SIGNATURE"}, sdk.ErrSignatureInvalid},
+		{"Maintenance", &sdk.APIError{Code: "SYSTEM_MAINTENANCE"}, sdk.ErrMaintenance},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := fmt.Errorf("failed to create order: %w", tc.apiErr)
+			assert.True(t, errors.Is(err, tc.sentinel))
+
+			var apiErr *sdk.APIError
+			if assert.True(t, errors.As(err, &apiErr)) {
+				assert.Equal(t, tc.apiErr.Code, apiErr.Code)
+			}
+		})
+	}
+
+	assert.False(t, errors.Is(&sdk.APIError{Code: "MARGIN_NOT_ENOUGH"}, sdk.ErrOrderNotFound))
+}
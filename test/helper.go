@@ -2,16 +2,33 @@ package test
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"runtime"
 	"strconv"
+	"testing"
 
 	"github.com/edgex-Tech/edgex-golang-sdk/sdk"
+	"github.com/edgex-Tech/edgex-golang-sdk/starkcurve"
 	"github.com/joho/godotenv"
+	"github.com/stretchr/testify/assert"
 )
 
+// Credentials of the clients created by NewMockClient
+const (
+	TestAccountID       int64 = 12345
+	TestStarkPrivateKey       = "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+)
+
+// MetaDataPath is the path of the metadata endpoint
+const MetaDataPath = "/api/v1/public/meta/getMetaData"
+
 func init() {
 	// Get the current file's directory
 	_, filename, _, _ := runtime.Caller(0)
@@ -52,6 +69,76 @@ func CreateTestClient() (*sdk.Client, error) {
 	})
 }
 
+// NewMockServer starts a server for offline tests that serves handlers by URL path with
+// JSON responses. metaData is served on MetaDataPath when not empty. Requests to other
+// paths fail the test.
+func NewMockServer(t testing.TB, metaData string, handlers map[string]http.HandlerFunc) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if metaData != "" && r.URL.Path == MetaDataPath {
+			w.Write([]byte(metaData))
+			return
+		}
+		handler, ok := handlers[r.URL.Path]
+		if !ok {
+			t.Errorf("unexpected request: %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		handler(w, r)
+	}))
+}
+
+// JSONResponse returns a handler that writes body
+func JSONResponse(body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(body))
+	}
+}
+
+// NewMockClient creates an SDK client for offline tests. The account ID and stark
+// private key default to TestAccountID and TestStarkPrivateKey.
+func NewMockClient(t testing.TB, cfg *sdk.ClientConfig) *sdk.Client {
+	if cfg.AccountID == 0 {
+		cfg.AccountID = TestAccountID
+	}
+	if cfg.StarkPriKey == "" {
+		cfg.StarkPriKey = TestStarkPrivateKey
+	}
+	client, err := sdk.NewClient(cfg)
+	assert.NoError(t, err)
+	return client
+}
+
+// VerifyL2Signature checks an L2 signature of TestStarkPrivateKey over msgHash
+func VerifyL2Signature(t testing.TB, msgHash []byte, signature string) {
+	t.Helper()
+	assert.Len(t, signature, 128)
+	sig, err := hex.DecodeString(signature)
+	assert.NoError(t, err)
+
+	privKey, _ := hex.DecodeString(TestStarkPrivateKey)
+	curve := starkcurve.NewStarkCurve()
+	pubX, pubY := curve.ScalarBaseMult(privKey)
+	hashInt := new(big.Int).Mod(new(big.Int).SetBytes(msgHash), curve.N)
+	assert.True(t, starkcurve.Verify(hashInt.Bytes(), pubX, pubY, new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:])))
+}
+
+// PackFields shifts each field of {bits, value} into value, most significant first
+func PackFields(value int64, fields ...[2]int64) *big.Int {
+	packed := big.NewInt(value)
+	for _, field := range fields {
+		packed.Lsh(packed, uint(field[0])).Add(packed, big.NewInt(field[1]))
+	}
+	return packed
+}
+
+// L2Nonce returns the L2 nonce of a client ID, the first 32 bits of its sha256
+func L2Nonce(clientId string) *big.Int {
+	sum := sha256.Sum256([]byte(clientId))
+	return new(big.Int).SetBytes(sum[:4])
+}
+
 // GetTestContext returns a context for testing
 func GetTestContext() context.Context {
 	return context.Background()
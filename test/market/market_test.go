@@ -0,0 +1,149 @@
+package market
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/edgex-Tech/edgex-golang-sdk/openapi"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/market"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestContract() openapi.Contract {
+	contract := openapi.Contract{}
+	contract.SetContractId("10000001")
+	contract.SetContractName("BTCUSDT")
+	contract.SetTickSize("0.5")
+	contract.SetStepSize("0.001")
+	contract.SetMinOrderSize("0.001")
+	contract.SetMaxOrderSize("100")
+	contract.SetStarkExResolution("0x2540be400")
+	return contract
+}
+
+func TestNewMarket(t *testing.T) {
+	m, err := market.NewMarket(newTestContract())
+	assert.NoError(t, err)
+	assert.Equal(t, "BTCUSDT", m.ContractName)
+	assert.True(t, decimal.RequireFromString("0.5").Equal(m.TickSize))
+	assert.Equal(t, int64(10000000000), m.Resolution)
+
+	contract := newTestContract()
+	contract.SetTickSize("abc")
+	_, err = market.NewMarket(contract)
+	assert.Error(t, err)
+}
+
+func TestRounding(t *testing.T) {
+	m, err := market.NewMarket(newTestContract())
+	assert.NoError(t, err)
+
+	price := decimal.RequireFromString("100.7")
+	assert.Equal(t, "100.5", m.RoundPrice(price, "BUY").String())
+	assert.Equal(t, "101", m.RoundPrice(price, "SELL").String())
+	assert.Equal(t, "1.234", m.RoundSize(decimal.RequireFromString("1.2349")).String())
+
+	size := decimal.RequireFromString("0.5")
+	amount := m.ToSyntheticAmount(size)
+	assert.Equal(t, int64(5000000000), amount)
+	assert.True(t, size.Equal(m.FromSyntheticAmount(amount)))
+}
+
+func TestValidateOrder(t *testing.T) {
+	m, err := market.NewMarket(newTestContract())
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		name  string
+		price string
+		size  string
+		field string
+	}{
+		{"Valid", "100.5", "0.01", ""},
+		{"Size Below Min", "100.5", "0.0001", "Size"},
+		{"Size Above Max", "100.5", "101", "Size"},
+		{"Size Off Step", "100.5", "0.0015", "Size"},
+		{"Price Off Tick", "100.2", "0.01", "Price"},
+		{"Price Zero", "0", "0.01", "Price"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := m.ValidateOrder(decimal.RequireFromString(tc.price), decimal.RequireFromString(tc.size))
+			if tc.field == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var validationErr *market.ValidationError
+			if assert.True(t, errors.As(err, &validationErr)) {
+				assert.Equal(t, tc.field, validationErr.Field)
+			}
+		})
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	metadata := openapi.MetaData{ContractList: []openapi.Contract{newTestContract()}}
+	registry, err := market.NewRegistry(metadata)
+	assert.NoError(t, err)
+
+	m, ok := registry.GetByName("BTCUSDT")
+	assert.True(t, ok)
+	assert.Equal(t, "10000001", m.ContractId)
+
+	_, ok = registry.Get("unknown")
+	assert.False(t, ok)
+	assert.Len(t, registry.Markets(), 1)
+}
+
+func TestValidatePriceBand(t *testing.T) {
+	contract := newTestContract()
+	contract.SetMaxOrderBuyPriceRatio("0.05")
+	contract.SetMinOrderSellPriceRatio("0.05")
+	m, err := market.NewMarket(contract)
+	assert.NoError(t, err)
+
+	oraclePrice := decimal.NewFromInt(100)
+	assert.NoError(t, m.ValidatePriceBand(decimal.NewFromInt(105), oraclePrice, "BUY"))
+	assert.Error(t, m.ValidatePriceBand(decimal.NewFromInt(106), oraclePrice, "BUY"))
+	assert.NoError(t, m.ValidatePriceBand(decimal.NewFromInt(95), oraclePrice, "SELL"))
+	assert.Error(t, m.ValidatePriceBand(decimal.NewFromInt(94), oraclePrice, "SELL"))
+}
+
+func TestResolveFeeRates(t *testing.T) {
+	contract := newTestContract()
+	contract.SetDefaultTakerFeeRate("0.0005")
+	contract.SetDefaultMakerFeeRate("0.0002")
+	m, err := market.NewMarket(contract)
+	assert.NoError(t, err)
+
+	// No account falls back to the contract defaults
+	rates, err := market.ResolveFeeRates(m, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "0.0005", rates.TakerFeeRate.String())
+	assert.Equal(t, "0.0002", rates.MakerFeeRate.String())
+
+	// Default trade setting with a discount
+	account := openapi.Account{}
+	discount := openapi.TradeSetting{}
+	discount.SetIsSetFeeDiscount(true)
+	discount.SetTakerFeeDiscount("0.2")
+	discount.SetMakerFeeDiscount("0.5")
+	account.SetDefaultTradeSetting(discount)
+	rates, err = market.ResolveFeeRates(m, &account)
+	assert.NoError(t, err)
+	assert.Equal(t, "0.0004", rates.TakerFeeRate.String())
+	assert.Equal(t, "0.0001", rates.MakerFeeRate.String())
+
+	// Contract trade setting takes precedence over the default setting
+	fixed := openapi.TradeSetting{}
+	fixed.SetIsSetFeeRate(true)
+	fixed.SetTakerFeeRate("0.0003")
+	fixed.SetMakerFeeRate("-0.0001")
+	account.SetContractIdToTradeSetting(map[string]openapi.TradeSetting{"10000001": fixed})
+	rates, err = market.ResolveFeeRates(m, &account)
+	assert.NoError(t, err)
+	assert.Equal(t, "0.0003", rates.TakerFeeRate.String())
+	assert.Equal(t, "-0.0001", rates.MakerFeeRate.String())
+}
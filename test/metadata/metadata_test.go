@@ -1,8 +1,12 @@
 package metadata
 
 import (
+	"net/http"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk"
 	"github.com/edgex-Tech/edgex-golang-sdk/test"
 	"github.com/stretchr/testify/assert"
 )
@@ -25,3 +29,107 @@ func TestGetMetadata(t *testing.T) {
 	assert.NotEmpty(t, data.GetContractList())
 	assert.NotNil(t, data.GetGlobal())
 }
+
+func TestMetadataCache(t *testing.T) {
+	client, err := test.CreateTestClient()
+	assert.NoError(t, err)
+
+	ctx := test.GetTestContext()
+	data, err := client.MetadataCache.Get(ctx)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, data.GetContractList())
+
+	// Lookups are served from the cached snapshot
+	contract := data.GetContractList()[0]
+	byID, err := client.MetadataCache.GetContract(ctx, contract.GetContractId())
+	assert.NoError(t, err)
+	assert.Equal(t, contract.GetContractName(), byID.GetContractName())
+
+	byName, err := client.MetadataCache.GetContractByName(ctx, contract.GetContractName())
+	assert.NoError(t, err)
+	assert.Equal(t, contract.GetContractId(), byName.GetContractId())
+
+	collateralCoin, err := client.MetadataCache.GetCollateralCoin(ctx)
+	assert.NoError(t, err)
+	coin, err := client.MetadataCache.GetCoin(ctx, collateralCoin.GetCoinId())
+	assert.NoError(t, err)
+	assert.Equal(t, collateralCoin.GetStarkExAssetId(), coin.GetStarkExAssetId())
+
+	_, err = client.MetadataCache.GetContract(ctx, "unknown")
+	assert.Error(t, err)
+
+	// Invalidation forces a new fetch
+	client.MetadataCache.Invalidate()
+	refreshed, err := client.MetadataCache.Get(ctx)
+	assert.NoError(t, err)
+	assert.NotSame(t, data, refreshed)
+}
+
+const testMetaData = `{"code":"SUCCESS","data":{
+	"global":{"starkExCollateralCoin":{"coinId":"1000","coinName":"USDT","starkExAssetId":"0x2"}},
+	"coinList":[{"coinId":"1000","coinName":"USDT","starkExAssetId":"0x2"}],
+	"contractList":[{"contractId":"10000001","contractName":"BTCUSDT","tickSize":"0.1","stepSize":"0.001",
+		"starkExSyntheticAssetId":"0x1","starkExResolution":"0x2540be400"}]}}`
+
+func TestMetadataCacheFetches(t *testing.T) {
+	var fetches int32
+	server := test.NewMockServer(t, "", map[string]http.HandlerFunc{
+		test.MetaDataPath: func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&fetches, 1)
+			w.Write([]byte(testMetaData))
+		},
+	})
+	defer server.Close()
+	ttl := 100 * time.Millisecond
+	client := test.NewMockClient(t, &sdk.ClientConfig{BaseURL: server.URL, MetadataCacheTTL: ttl})
+	cache := client.MetadataCache
+	ctx := test.GetTestContext()
+
+	data, err := cache.Get(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, data.GetContractList(), 1)
+
+	// Lookups are served from the indexes of the cached snapshot
+	contract, err := cache.GetContract(ctx, "10000001")
+	assert.NoError(t, err)
+	assert.Equal(t, "BTCUSDT", contract.GetContractName())
+	contract, err = cache.GetContractByName(ctx, "BTCUSDT")
+	assert.NoError(t, err)
+	assert.Equal(t, "10000001", contract.GetContractId())
+	mkt, err := cache.GetMarket(ctx, "10000001")
+	assert.NoError(t, err)
+	assert.Equal(t, "0.1", mkt.TickSize.String())
+	coin, err := cache.GetCoin(ctx, "1000")
+	assert.NoError(t, err)
+	assert.Equal(t, "USDT", coin.GetCoinName())
+	collateralCoin, err := cache.GetCollateralCoin(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "1000", collateralCoin.GetCoinId())
+
+	_, err = cache.GetContract(ctx, "unknown")
+	assert.Error(t, err)
+	_, err = cache.GetContractByName(ctx, "unknown")
+	assert.Error(t, err)
+	_, err = cache.GetCoin(ctx, "unknown")
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&fetches))
+
+	// Expired metadata is fetched again
+	time.Sleep(ttl + 20*time.Millisecond)
+	_, err = cache.GetContract(ctx, "10000001")
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&fetches))
+
+	// Invalidation forces a new fetch
+	cache.Invalidate()
+	refreshed, err := cache.Get(ctx)
+	assert.NoError(t, err)
+	assert.NotSame(t, data, refreshed)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&fetches))
+
+	// Refresh fetches at once and the result is cached
+	assert.NoError(t, cache.Refresh(ctx))
+	_, err = cache.Get(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(4), atomic.LoadInt32(&fetches))
+}
@@ -2,10 +2,14 @@ package order
 
 import (
 	"encoding/json"
+	"net/http"
+	"sync/atomic"
 	"testing"
 	"strings"
+	"time"
 
 	"github.com/edgex-Tech/edgex-golang-sdk/openapi"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk"
 	"github.com/edgex-Tech/edgex-golang-sdk/sdk/order"
 	"github.com/edgex-Tech/edgex-golang-sdk/test"
 	"github.com/shopspring/decimal"
@@ -173,3 +177,203 @@ func TestCreateMarketOrder(t *testing.T) {
 		}
 	})
 }
+
+func TestCreateAndCancelStopOrder(t *testing.T) {
+	client, err := test.CreateTestClient()
+	assert.NoError(t, err)
+
+	ctx := test.GetTestContext()
+	contractID := "10000001" // BTCUSDT
+
+	// Place the stop well above the current price so it does not trigger
+	quote, err := client.Get24HourQuote(ctx, contractID)
+	assert.NoError(t, err)
+	if !assert.NotNil(t, quote) || !assert.NotEmpty(t, quote.Data) {
+		return
+	}
+	lastPrice, err := decimal.NewFromString(quote.Data[0].GetLastPrice())
+	assert.NoError(t, err)
+	triggerPrice := lastPrice.Mul(decimal.NewFromFloat(1.5)).Round(0)
+
+	resp, err := client.CreateOrder(ctx, &order.CreateOrderParams{
+		ContractId:       contractID,
+		Size:             "0.001",
+		Type:             order.OrderTypeStopMarket,
+		Side:             order.OrderSideBuy,
+		TriggerPrice:     triggerPrice.String(),
+		TriggerPriceType: order.TriggerPriceTypeLast,
+	})
+	jsonData, _ := json.MarshalIndent(resp, "", "  ")
+	t.Logf("Created Stop Order: %s", string(jsonData))
+
+	assert.NoError(t, err)
+	if assert.NotNil(t, resp) && assert.NotNil(t, resp.Data) {
+		cancelResp, err := client.CancelOrder(ctx, &order.CancelOrderParams{
+			OrderId: resp.Data.GetOrderId(),
+		})
+		assert.NoError(t, err)
+		assert.NotNil(t, cancelResp)
+	}
+}
+
+func TestCreateBracketOrder(t *testing.T) {
+	client, err := test.CreateTestClient()
+	assert.NoError(t, err)
+
+	ctx := test.GetTestContext()
+	contractID := "10000002"
+	price := decimal.NewFromFloat(3300.1)
+
+	resp, err := client.CreateBracketOrder(ctx, &order.BracketOrderParams{
+		CreateOrderParams: order.CreateOrderParams{
+			ContractId: contractID,
+			Price:      price.String(),
+			Size:       "0.1",
+			Type:       order.OrderTypeLimit,
+			Side:       order.OrderSideBuy,
+		},
+		TakeProfit: &order.TpSlParams{TriggerPrice: price.Mul(decimal.NewFromFloat(1.1)).Round(2).String()},
+		StopLoss:   &order.TpSlParams{TriggerPrice: price.Mul(decimal.NewFromFloat(0.9)).Round(2).String()},
+	})
+	jsonData, _ := json.MarshalIndent(resp, "", "  ")
+	t.Logf("Created Bracket Order: %s", string(jsonData))
+
+	assert.NoError(t, err)
+	if assert.NotNil(t, resp) && assert.NotNil(t, resp.Data) {
+		cancelResp, err := client.CancelOrder(ctx, &order.CancelOrderParams{
+			OrderId: resp.Data.GetOrderId(),
+		})
+		assert.NoError(t, err)
+		assert.NotNil(t, cancelResp)
+	}
+}
+
+func TestCreateGoodTilTimeOrder(t *testing.T) {
+	client, err := test.CreateTestClient()
+	assert.NoError(t, err)
+
+	ctx := test.GetTestContext()
+
+	resp, err := client.CreateOrder(ctx, &order.CreateOrderParams{
+		ContractId:  "10000002",
+		Price:       "3300.1",
+		Size:        "0.1",
+		Type:        order.OrderTypeLimit,
+		Side:        order.OrderSideBuy,
+		ExpireAfter: time.Hour,
+	})
+	jsonData, _ := json.MarshalIndent(resp, "", "  ")
+	t.Logf("Created Good-Til-Time Order: %s", string(jsonData))
+
+	assert.NoError(t, err)
+	if assert.NotNil(t, resp) && assert.NotNil(t, resp.Data) {
+		_, err := client.CancelOrder(ctx, &order.CancelOrderParams{
+			OrderId: resp.Data.GetOrderId(),
+		})
+		assert.NoError(t, err)
+	}
+
+	// Orders must not expire in the past
+	_, err = client.CreateOrder(ctx, &order.CreateOrderParams{
+		ContractId:  "10000002",
+		Price:       "3300.1",
+		Size:        "0.1",
+		Type:        order.OrderTypeLimit,
+		Side:        order.OrderSideBuy,
+		ExpireAfter: -time.Hour,
+	})
+	assert.Error(t, err)
+}
+
+func TestGetHistoryOrders(t *testing.T) {
+	client, err := test.CreateTestClient()
+	assert.NoError(t, err)
+
+	ctx := test.GetTestContext()
+	contractID := "10000001" // BTCUSDT
+
+	historyOrders, err := client.GetHistoryOrderPage(ctx, &order.GetHistoryOrderParams{
+		PaginationParams: order.PaginationParams{
+			Size: "10",
+		},
+		OrderFilterParams: order.OrderFilterParams{
+			FilterContractIdList: []string{contractID},
+		},
+	})
+	jsonData, _ := json.MarshalIndent(historyOrders, "", "  ")
+	t.Logf("History Orders: %s", string(jsonData))
+
+	assert.NoError(t, err)
+
+	if assert.NotNil(t, historyOrders) && assert.NotNil(t, historyOrders.Data) && len(historyOrders.Data.DataList) > 0 {
+		historyOrder := historyOrders.Data.DataList[0]
+
+		// Lookups by ID find historical orders
+		byID, err := client.GetHistoryOrderById(ctx, []string{historyOrder.GetId()})
+		assert.NoError(t, err)
+		if assert.NotNil(t, byID) && assert.NotEmpty(t, byID.Data) {
+			assert.Equal(t, historyOrder.GetId(), byID.Data[0].GetId())
+		}
+
+		// GetOrder falls back to the history
+		found, err := client.GetOrder(ctx, &order.GetOrderParams{OrderId: historyOrder.GetId()})
+		assert.NoError(t, err)
+		if assert.NotNil(t, found) && assert.NotEmpty(t, found.Data) {
+			assert.Equal(t, historyOrder.GetId(), found.Data[0].GetId())
+		}
+	}
+}
+
+func TestGetOrderFallsBackToHistory(t *testing.T) {
+	var active, history int32
+	server := test.NewMockServer(t, "", map[string]http.HandlerFunc{
+		"/api/v1/private/order/getOrderById": func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&active, 1)
+			if r.URL.Query().Get("orderIdList") == "1" {
+				w.Write([]byte(`{"code":"SUCCESS","data":[{"id":"1","status":"OPEN"}]}`))
+				return
+			}
+			w.Write([]byte(`{"code":"SUCCESS","data":[]}`))
+		},
+		"/api/v1/private/order/getHistoryOrderById": func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&history, 1)
+			assert.Equal(t, "2", r.URL.Query().Get("orderIdList"))
+			w.Write([]byte(`{"code":"SUCCESS","data":[{"id":"2","status":"FILLED"}]}`))
+		},
+		"/api/v1/private/order/getOrderByClientOrderId": func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&active, 1)
+			w.Write([]byte(`{"code":"ORDER_NOT_FOUND","errorParam":{}}`))
+		},
+		"/api/v1/private/order/getHistoryOrderByClientOrderId": func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&history, 1)
+			assert.Equal(t, "client-3", r.URL.Query().Get("clientOrderIdList"))
+			w.Write([]byte(`{"code":"SUCCESS","data":[{"id":"3","clientOrderId":"client-3","status":"CANCELED"}]}`))
+		},
+	})
+	defer server.Close()
+	client := test.NewMockClient(t, &sdk.ClientConfig{BaseURL: server.URL})
+	ctx := test.GetTestContext()
+
+	// Active orders are not looked up in the history
+	result, err := client.GetOrder(ctx, &order.GetOrderParams{OrderId: "1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "OPEN", string(result.GetData()[0].GetStatus()))
+	assert.Equal(t, int32(0), atomic.LoadInt32(&history))
+
+	// An empty active result falls back to the history
+	result, err = client.GetOrder(ctx, &order.GetOrderParams{OrderId: "2"})
+	assert.NoError(t, err)
+	if assert.Len(t, result.GetData(), 1) {
+		assert.Equal(t, "FILLED", string(result.GetData()[0].GetStatus()))
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&history))
+
+	// So does an order not found error
+	result, err = client.GetOrder(ctx, &order.GetOrderParams{ClientId: "client-3"})
+	assert.NoError(t, err)
+	if assert.Len(t, result.GetData(), 1) {
+		assert.Equal(t, "3", result.GetData()[0].GetId())
+	}
+	assert.Equal(t, int32(3), atomic.LoadInt32(&active))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&history))
+}
@@ -0,0 +1,232 @@
+package orderbook
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/edgex-Tech/edgex-golang-sdk/openapi"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/orderbook"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/quote"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/ws"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeFetcher serves a depth snapshot and counts requests. Requests wait for gate
+// when it is set.
+type fakeFetcher struct {
+	mu    sync.Mutex
+	depth openapi.Depth
+	calls int
+	gate  chan struct{}
+}
+
+func (f *fakeFetcher) GetOrderBookDepth(ctx context.Context, params quote.GetOrderBookDepthParams) (*openapi.ResultListDepth, error) {
+	if f.gate != nil {
+		<-f.gate
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls++
+	resp := openapi.NewResultListDepth()
+	resp.SetData([]openapi.Depth{f.depth})
+	return resp, nil
+}
+
+func (f *fakeFetcher) setDepth(depth openapi.Depth) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.depth = depth
+}
+
+func (f *fakeFetcher) callCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.calls
+}
+
+func levels(pairs ...string) []openapi.BookOrder {
+	var orders []openapi.BookOrder
+	for i := 0; i < len(pairs); i += 2 {
+		order := openapi.NewBookOrder()
+		order.SetPrice(pairs[i])
+		order.SetSize(pairs[i+1])
+		orders = append(orders, *order)
+	}
+	return orders
+}
+
+func depth(startVersion, endVersion string, bids, asks []openapi.BookOrder) openapi.Depth {
+	d := openapi.NewDepth()
+	d.SetContractId("10000001")
+	d.SetStartVersion(startVersion)
+	d.SetEndVersion(endVersion)
+	d.SetBids(bids)
+	d.SetAsks(asks)
+	return *d
+}
+
+func dec(s string) decimal.Decimal {
+	return decimal.RequireFromString(s)
+}
+
+func TestBookAppliesDeltas(t *testing.T) {
+	ctx := context.Background()
+	book := orderbook.New("10000001", &fakeFetcher{}, nil)
+
+	_, err := book.BestBid()
+	assert.True(t, errors.Is(err, orderbook.ErrNotSynced))
+
+	assert.NoError(t, book.Apply(ctx, ws.DataTypeSnapshot, depth("1", "10",
+		levels("99", "1", "98", "2", "97", "3"),
+		levels("101", "1", "102", "2"))))
+	assert.NoError(t, book.Apply(ctx, ws.DataTypeChanged, depth("11", "11",
+		levels("99", "0", "98.5", "4"),
+		levels("100.5", "1"))))
+	// A stale update is dropped
+	assert.NoError(t, book.Apply(ctx, ws.DataTypeChanged, depth("9", "10", levels("96", "5"), nil)))
+	assert.Equal(t, int64(11), book.Version())
+
+	bid, err := book.BestBid()
+	assert.NoError(t, err)
+	assert.True(t, bid.Price.Equal(dec("98.5")))
+	ask, err := book.BestAsk()
+	assert.NoError(t, err)
+	assert.True(t, ask.Price.Equal(dec("100.5")))
+
+	size, err := book.SizeAt(orderbook.SideBid, dec("98"))
+	assert.NoError(t, err)
+	assert.True(t, size.Equal(dec("2")))
+	size, err = book.SizeAt(orderbook.SideBid, dec("96"))
+	assert.NoError(t, err)
+	assert.True(t, size.IsZero())
+
+	bids, err := book.Levels(orderbook.SideBid, 0)
+	assert.NoError(t, err)
+	assert.Len(t, bids, 3)
+
+	// 100.5 * 1 + 101 * 1 fills 201.5, then 102 more from the 102 level
+	size, avgPrice, ok, err := book.SizeForNotional(orderbook.SideAsk, dec("303.5"))
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.True(t, size.Equal(dec("3")), size.String())
+	assert.Equal(t, "101.1667", avgPrice.StringFixed(4))
+
+	_, _, ok, err = book.SizeForNotional(orderbook.SideAsk, dec("100000"))
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestBookResyncsOnGap(t *testing.T) {
+	ctx := context.Background()
+	fetcher := &fakeFetcher{depth: depth("", "20", levels("95", "1"), levels("105", "1"))}
+	var mu sync.Mutex
+	var synced []int64
+	var errs []error
+	book := orderbook.New("10000001", fetcher, &orderbook.Options{
+		ResyncBackoff: 10 * time.Millisecond,
+		OnSync: func(version int64) {
+			mu.Lock()
+			defer mu.Unlock()
+			synced = append(synced, version)
+		},
+		OnError: func(err error) {
+			mu.Lock()
+			defer mu.Unlock()
+			errs = append(errs, err)
+		},
+	})
+
+	// A change before any snapshot resyncs as well
+	assert.NoError(t, book.Apply(ctx, ws.DataTypeChanged, depth("5", "5", levels("99", "1"), nil)))
+	assert.Eventually(t, book.Synced, time.Second, time.Millisecond)
+	assert.Equal(t, 1, fetcher.callCount())
+	assert.Equal(t, int64(20), book.Version())
+
+	assert.NoError(t, book.Apply(ctx, ws.DataTypeChanged, depth("21", "21", levels("96", "1"), nil)))
+	assert.Equal(t, int64(21), book.Version())
+
+	// The snapshot is behind the held back change, so the resync retries until the
+	// endpoint catches up
+	assert.NoError(t, book.Apply(ctx, ws.DataTypeChanged, depth("25", "26", levels("97", "1"), nil)))
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(errs) > 0
+	}, time.Second, time.Millisecond)
+	assert.False(t, book.Synced())
+	fetcher.setDepth(depth("", "24", levels("95", "1"), levels("105", "1")))
+	assert.Eventually(t, book.Synced, time.Second, time.Millisecond)
+	assert.Equal(t, int64(26), book.Version())
+
+	mu.Lock()
+	assert.Equal(t, []int64{20, 26}, synced)
+	mu.Unlock()
+
+	bid, err := book.BestBid()
+	assert.NoError(t, err)
+	assert.True(t, bid.Price.Equal(dec("97")))
+}
+
+func TestBookBuffersDeltasDuringResync(t *testing.T) {
+	ctx := context.Background()
+	fetcher := &fakeFetcher{
+		depth: depth("", "12", levels("95", "1"), levels("105", "1")),
+		gate:  make(chan struct{}),
+	}
+	book := orderbook.New("10000001", fetcher, nil)
+	assert.NoError(t, book.Apply(ctx, ws.DataTypeSnapshot, depth("1", "10", levels("99", "1"), levels("101", "1"))))
+
+	// The gap starts a resync without blocking, later changes are held back
+	assert.NoError(t, book.Apply(ctx, ws.DataTypeChanged, depth("12", "12", levels("98", "1"), nil)))
+	assert.NoError(t, book.Apply(ctx, ws.DataTypeChanged, depth("13", "13", levels("96", "2"), nil)))
+	assert.NoError(t, book.Apply(ctx, ws.DataTypeChanged, depth("14", "14", nil, levels("104", "3"))))
+	assert.False(t, book.Synced())
+	_, err := book.BestBid()
+	assert.True(t, errors.Is(err, orderbook.ErrNotSynced))
+
+	// Changes newer than the snapshot are applied on top of it
+	close(fetcher.gate)
+	assert.Eventually(t, book.Synced, time.Second, time.Millisecond)
+	assert.Equal(t, int64(14), book.Version())
+	assert.Equal(t, 1, fetcher.callCount())
+
+	size, err := book.SizeAt(orderbook.SideBid, dec("96"))
+	assert.NoError(t, err)
+	assert.True(t, size.Equal(dec("2")))
+	size, err = book.SizeAt(orderbook.SideBid, dec("98"))
+	assert.NoError(t, err)
+	assert.True(t, size.IsZero())
+	ask, err := book.BestAsk()
+	assert.NoError(t, err)
+	assert.True(t, ask.Price.Equal(dec("104")))
+}
+
+func TestBookConcurrentReads(t *testing.T) {
+	ctx := context.Background()
+	book := orderbook.New("10000001", &fakeFetcher{}, nil)
+	assert.NoError(t, book.Apply(ctx, ws.DataTypeSnapshot, depth("1", "1", levels("99", "1"), levels("101", "1"))))
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 2; i < 200; i++ {
+			version := decimal.NewFromInt(int64(i)).String()
+			book.Apply(ctx, ws.DataTypeChanged, depth(version, version, levels("98", version), levels("102", version)))
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 200; i++ {
+			book.BestBid()
+			book.Levels(orderbook.SideAsk, 5)
+			book.SizeForNotional(orderbook.SideAsk, dec("150"))
+		}
+	}()
+	wg.Wait()
+	assert.Equal(t, int64(199), book.Version())
+}
@@ -0,0 +1,112 @@
+package pager
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/edgex-Tech/edgex-golang-sdk/openapi"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/account"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/order"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/pager"
+	"github.com/edgex-Tech/edgex-golang-sdk/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// newMockServer serves total active orders with IDs 0..total-1 and position
+// transactions with one record per hour of their time filter
+func newMockServer(t *testing.T, total int, sizes *[]string) *httptest.Server {
+	return test.NewMockServer(t, "", map[string]http.HandlerFunc{
+		"/api/v1/private/order/getActiveOrderPage": func(w http.ResponseWriter, r *http.Request) {
+			query := r.URL.Query()
+			*sizes = append(*sizes, query.Get("size"))
+			size, _ := strconv.Atoi(query.Get("size"))
+			offset, _ := strconv.Atoi(query.Get("offsetData"))
+			var orders []map[string]string
+			for i := offset; i < offset+size && i < total; i++ {
+				orders = append(orders, map[string]string{"id": strconv.Itoa(i)})
+			}
+			data := map[string]interface{}{"dataList": orders}
+			if offset+size < total {
+				data["nextPageOffsetData"] = strconv.Itoa(offset + size)
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"code": "SUCCESS", "data": data})
+		},
+		"/api/v1/private/account/getPositionTransactionPage": func(w http.ResponseWriter, r *http.Request) {
+			query := r.URL.Query()
+			start, _ := strconv.ParseInt(query.Get("filterStartCreatedTimeInclusive"), 10, 64)
+			end, _ := strconv.ParseInt(query.Get("filterEndCreatedTimeExclusive"), 10, 64)
+			var txs []map[string]string
+			for ts := start; ts < end; ts += time.Hour.Milliseconds() {
+				txs = append(txs, map[string]string{"createdTime": strconv.FormatInt(ts, 10)})
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"code": "SUCCESS", "data": map[string]interface{}{"dataList": txs}})
+		},
+	})
+}
+
+func TestActiveOrdersPager(t *testing.T) {
+	var sizes []string
+	server := newMockServer(t, 25, &sizes)
+	defer server.Close()
+	client := test.NewMockClient(t, &sdk.ClientConfig{BaseURL: server.URL})
+
+	p := client.ActiveOrdersPager(&order.GetActiveOrderParams{}, &pager.Options{PageSize: 10})
+	var ids []string
+	for p.Next(context.Background()) {
+		order := p.Value()
+		ids = append(ids, order.GetId())
+	}
+	assert.NoError(t, p.Err())
+	assert.Len(t, ids, 25)
+	assert.Equal(t, "24", ids[24])
+	assert.Equal(t, []string{"10", "10", "10"}, sizes)
+}
+
+func TestActiveOrdersPagerMaxItems(t *testing.T) {
+	var sizes []string
+	server := newMockServer(t, 25, &sizes)
+	defer server.Close()
+	client := test.NewMockClient(t, &sdk.ClientConfig{BaseURL: server.URL})
+
+	orders, err := client.ActiveOrdersPager(nil, &pager.Options{PageSize: 10, MaxItems: 15}).All(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, orders, 15)
+	// The last page only requests the remaining records
+	assert.Equal(t, []string{"10", "5"}, sizes)
+}
+
+func TestCollectPositionTransactions(t *testing.T) {
+	var sizes []string
+	server := newMockServer(t, 0, &sizes)
+	defer server.Close()
+	client := test.NewMockClient(t, &sdk.ClientConfig{BaseURL: server.URL})
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	w := pager.Window{Start: start, End: start.Add(30 * time.Hour), Span: 12 * time.Hour}
+	txs, err := client.Account.CollectPositionTransactions(context.Background(), account.GetPositionTransactionPageParams{}, w, nil)
+	assert.NoError(t, err)
+	assert.Len(t, txs, 30)
+	assert.Equal(t, strconv.FormatInt(start.Add(29*time.Hour).UnixMilli(), 10), txs[29].GetCreatedTime())
+
+	txs, err = client.Account.CollectPositionTransactions(context.Background(), account.GetPositionTransactionPageParams{}, w, &pager.Options{MaxItems: 20})
+	assert.NoError(t, err)
+	assert.Len(t, txs, 20)
+}
+
+func TestPagerStopsOnRepeatedOffset(t *testing.T) {
+	calls := 0
+	p := pager.New(func(ctx context.Context, offset string, size int) ([]openapi.Order, string, error) {
+		calls++
+		return []openapi.Order{{}}, "same", nil
+	}, nil)
+	items, err := p.All(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, items, 2)
+	assert.Equal(t, 2, calls)
+}
@@ -0,0 +1,68 @@
+package ratelimit
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/order"
+	"github.com/edgex-Tech/edgex-golang-sdk/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestServer(t *testing.T) *httptest.Server {
+	return test.NewMockServer(t, "", map[string]http.HandlerFunc{
+		"/api/v1/public/meta/getServerTime":     test.JSONResponse(`{"code":"SUCCESS"}`),
+		"/api/v1/private/order/cancelOrderById": test.JSONResponse(`{"code":"SUCCESS"}`),
+		"/api/v1/private/account/getAccountById": test.JSONResponse(
+			`{"code":"SUCCESS","data":{"id":"12345","createOrderPerMinuteLimit":1,"createOrderDelayMillis":0}}`),
+	})
+}
+
+func newTestClient(t *testing.T, url string, cfg *sdk.RateLimitConfig) *sdk.Client {
+	return test.NewMockClient(t, &sdk.ClientConfig{BaseURL: url, RateLimit: cfg})
+}
+
+func TestRateLimitBlocks(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+
+	client := newTestClient(t, server.URL, &sdk.RateLimitConfig{
+		PublicPerSecond: 20,
+		PublicBurst:     1,
+	})
+
+	ctx := test.GetTestContext()
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		_, err := client.GetServerTime(ctx)
+		assert.NoError(t, err)
+	}
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+}
+
+func TestRateLimitFailFast(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+
+	client := newTestClient(t, server.URL, &sdk.RateLimitConfig{
+		OrderPerMinute: 600,
+		OrderBurst:     100,
+		FailFast:       true,
+	})
+
+	ctx := test.GetTestContext()
+	assert.NoError(t, client.ConfigureRateLimitFromAccount(ctx))
+
+	_, err := client.CancelOrder(ctx, &order.CancelOrderParams{OrderId: "1"})
+	assert.NoError(t, err)
+
+	_, err = client.CancelOrder(ctx, &order.CancelOrderParams{OrderId: "2"})
+	assert.ErrorIs(t, err, sdk.ErrRateLimited)
+
+	// Private reads use a separate bucket
+	_, err = client.GetAccountByID(ctx)
+	assert.NoError(t, err)
+}
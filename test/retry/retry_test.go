@@ -0,0 +1,84 @@
+package retry
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/order"
+	"github.com/edgex-Tech/edgex-golang-sdk/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestClient(t *testing.T, url string) *sdk.Client {
+	return test.NewMockClient(t, &sdk.ClientConfig{
+		BaseURL: url,
+		RetryPolicy: &sdk.RetryPolicy{
+			MaxAttempts:    3,
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     10 * time.Millisecond,
+			Multiplier:     2,
+		},
+	})
+}
+
+func TestRetryOnServerError(t *testing.T) {
+	var attempts int32
+	var timestamps []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		timestamps = append(timestamps, r.Header.Get("X-edgeX-Api-Timestamp"))
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"code":"SUCCESS","data":{"timeMillis":"1700000000000"}}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	result, err := client.GetServerTime(test.GetTestContext())
+	assert.NoError(t, err)
+	assert.Equal(t, "SUCCESS", result.GetCode())
+	assert.Equal(t, int32(3), atomic.LoadInt32(&attempts))
+	for _, ts := range timestamps {
+		assert.NotEmpty(t, ts)
+	}
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	_, err := client.GetServerTime(test.GetTestContext())
+	assert.ErrorIs(t, err, sdk.ErrRateLimited)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&attempts))
+}
+
+func TestRetryOnlyIdempotentWrites(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	_, err := client.CancelOrder(test.GetTestContext(), &order.CancelOrderParams{OrderId: "1"})
+	assert.Error(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&attempts), "cancels are idempotent")
+
+	atomic.StoreInt32(&attempts, 0)
+	_, err = client.Order.GetMaxOrderSize(test.GetTestContext(), "10000001", 1)
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&attempts), "non-idempotent POST must not be retried")
+}
@@ -0,0 +1,117 @@
+package transfer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/edgex-Tech/edgex-golang-sdk/openapi"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/transfer"
+	"github.com/edgex-Tech/edgex-golang-sdk/test"
+	"github.com/stretchr/testify/assert"
+)
+
+const testMetaData = `{"code":"SUCCESS","data":{
+	"global":{"starkExCollateralCoin":{"coinId":"1000","starkExAssetId":"0x2"}}}}`
+
+// transferStates serves a transfer out that moves through the given statuses, one per request
+func transferStates(t *testing.T, states ...string) http.HandlerFunc {
+	var calls int32
+	return func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "1", r.URL.Query().Get("transferOutIdList"))
+		i := int(atomic.AddInt32(&calls, 1)) - 1
+		if i >= len(states) {
+			i = len(states) - 1
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"code": "SUCCESS",
+			"data": []map[string]string{{"id": "1", "status": states[i], "censorFailReason": "blocked"}},
+		})
+	}
+}
+
+func TestWaitForTransfer(t *testing.T) {
+	server := test.NewMockServer(t, testMetaData, map[string]http.HandlerFunc{
+		"/api/v1/private/transfer/getTransferOutById": transferStates(t,
+			"PENDING_CHECKING", "PENDING_CENSORING", "SUCCESS_CENSOR_SUCCESS", "SUCCESS_L2_APPROVED"),
+	})
+	defer server.Close()
+	client := test.NewMockClient(t, &sdk.ClientConfig{BaseURL: server.URL})
+
+	var statuses []string
+	result, err := client.WaitForTransfer(context.Background(), "1", &transfer.WaitForTransferParams{
+		PollInterval: 10 * time.Millisecond,
+		OnUpdate: func(out *openapi.TransferOut) {
+			statuses = append(statuses, out.GetStatus())
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "SUCCESS_L2_APPROVED", result.GetStatus())
+	assert.Equal(t, []string{"PENDING_CHECKING", "PENDING_CENSORING", "SUCCESS_CENSOR_SUCCESS", "SUCCESS_L2_APPROVED"}, statuses)
+}
+
+func TestWaitForTransferRejected(t *testing.T) {
+	server := test.NewMockServer(t, testMetaData, map[string]http.HandlerFunc{
+		"/api/v1/private/transfer/getTransferOutById": transferStates(t, "PENDING_CENSORING", "FAILED_CENSOR_FAILURE"),
+	})
+	defer server.Close()
+	client := test.NewMockClient(t, &sdk.ClientConfig{BaseURL: server.URL})
+
+	_, err := client.WaitForTransfer(context.Background(), "1", &transfer.WaitForTransferParams{PollInterval: 10 * time.Millisecond})
+	assert.True(t, errors.Is(err, sdk.ErrTransferFailed))
+	assert.Contains(t, err.Error(), "blocked")
+}
+
+func TestActiveTransferOutsPager(t *testing.T) {
+	server := test.NewMockServer(t, testMetaData, map[string]http.HandlerFunc{
+		"/api/v1/private/transfer/getActiveTransferOut": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "USER_TRANSFER", r.URL.Query().Get("filterTransferReasonList"))
+			page := map[string]interface{}{"dataList": []map[string]string{{"id": "1"}, {"id": "2"}}, "nextPageOffsetData": "next"}
+			if r.URL.Query().Get("offsetData") == "next" {
+				page = map[string]interface{}{"dataList": []map[string]string{{"id": "3"}}}
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"code": "SUCCESS", "data": page})
+		},
+	})
+	defer server.Close()
+	client := test.NewMockClient(t, &sdk.ClientConfig{BaseURL: server.URL})
+
+	transfers, err := client.ActiveTransferOutsPager(transfer.TransferFilterParams{
+		FilterTransferReasonList: []string{"USER_TRANSFER"},
+	}, nil).All(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, transfers, 3)
+}
+
+func TestInternalTransfer(t *testing.T) {
+	var param map[string]string
+	server := test.NewMockServer(t, testMetaData, map[string]http.HandlerFunc{
+		"/api/v1/private/account/getAccountById": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "67890", r.URL.Query().Get("accountId"))
+			w.Write([]byte(`{"code":"SUCCESS","data":{"id":"67890","l2Key":"0x3a1b2c"}}`))
+		},
+		"/api/v1/private/transfer/createTransferOut": func(w http.ResponseWriter, r *http.Request) {
+			json.NewDecoder(r.Body).Decode(&param)
+			w.Write([]byte(`{"code":"SUCCESS","data":{"transferOutId":"1"}}`))
+		},
+	})
+	defer server.Close()
+	client := test.NewMockClient(t, &sdk.ClientConfig{BaseURL: server.URL})
+
+	resp, err := client.InternalTransfer(context.Background(), transfer.CreateTransferOutParams{
+		CoinId:            "1000",
+		Amount:            "5",
+		ReceiverAccountId: "67890",
+	})
+	assert.NoError(t, err)
+	data := resp.GetData()
+	assert.Equal(t, "1", data.GetTransferOutId())
+	assert.Equal(t, "0x3a1b2c", param["receiverL2Key"])
+	assert.Equal(t, "67890", param["receiverAccountId"])
+	assert.Len(t, param["l2Signature"], 128)
+}
@@ -0,0 +1,207 @@
+package validate
+
+import (
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/edgex-Tech/edgex-golang-sdk/openapi"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/order"
+	"github.com/edgex-Tech/edgex-golang-sdk/test"
+	"github.com/stretchr/testify/assert"
+)
+
+const testMetaData = `{"code":"SUCCESS","data":{
+	"global":{"starkExCollateralCoin":{"coinId":"1000","starkExAssetId":"0x2"}},
+	"contractList":[{"contractId":"10000001","contractName":"BTCUSDT","tickSize":"0.1","stepSize":"0.001",
+		"enableTrade":true,"enableOpenPosition":true,"maxOrderBuyPriceRatio":"0.05","minOrderSellPriceRatio":"0.05",
+		"defaultTakerFeeRate":"0.0005","defaultMakerFeeRate":"0.0002",
+		"starkExSyntheticAssetId":"0x1","starkExResolution":"0x2540be400"}]}}`
+
+func newTestClient(t *testing.T, tickers *int32) (*sdk.Client, func()) {
+	server := test.NewMockServer(t, testMetaData, map[string]http.HandlerFunc{
+		"/api/v1/private/account/getAccountById": test.JSONResponse(`{"code":"SUCCESS","data":{"id":"12345"}}`),
+		"/api/v1/private/order/createOrder":      test.JSONResponse(`{"code":"SUCCESS","data":{"orderId":"1"}}`),
+		"/api/v1/public/quote/getTicker": func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(tickers, 1)
+			w.Write([]byte(`{"code":"SUCCESS","data":[{"contractId":"10000001","oraclePrice":"30000","lastPrice":"30000"}]}`))
+		},
+	})
+	return test.NewMockClient(t, &sdk.ClientConfig{BaseURL: server.URL}), server.Close
+}
+
+func limitOrder(price string) *order.CreateOrderParams {
+	return &order.CreateOrderParams{
+		ContractId: "10000001",
+		Price:      price,
+		Size:       "0.1",
+		Type:       order.OrderTypeLimit,
+		Side:       order.OrderSideBuy,
+	}
+}
+
+func TestPriceBandUsesCachedTicker(t *testing.T) {
+	var tickers int32
+	client, closeServer := newTestClient(t, &tickers)
+	defer closeServer()
+	ctx := test.GetTestContext()
+
+	for i := 0; i < 3; i++ {
+		_, err := client.CreateOrder(ctx, limitOrder("30000"))
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&tickers))
+
+	// Above 30000 * 1.05
+	_, err := client.CreateOrder(ctx, limitOrder("31600"))
+	var validationErr *order.ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, "Price", validationErr.Field)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&tickers))
+
+	// A stale ticker is fetched again
+	client.Order.SetPriceMaxAge(time.Millisecond)
+	time.Sleep(5 * time.Millisecond)
+	_, err = client.CreateOrder(ctx, limitOrder("30000"))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&tickers))
+}
+
+func TestPriceBandUsesStreamedTicker(t *testing.T) {
+	var tickers int32
+	client, closeServer := newTestClient(t, &tickers)
+	defer closeServer()
+
+	ticker := openapi.NewTicker()
+	ticker.SetContractId("10000001")
+	ticker.SetOraclePrice("32000")
+	client.Order.UpdateTicker(*ticker)
+
+	// In the band of the streamed price only
+	_, err := client.CreateOrder(test.GetTestContext(), limitOrder("33000"))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&tickers))
+}
+
+func TestBracketOrderLegValidation(t *testing.T) {
+	var created int32
+	server := test.NewMockServer(t, testMetaData, map[string]http.HandlerFunc{
+		"/api/v1/private/account/getAccountById": test.JSONResponse(`{"code":"SUCCESS","data":{"id":"12345"}}`),
+		"/api/v1/private/order/createOrder": func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&created, 1)
+			w.Write([]byte(`{"code":"SUCCESS","data":{"orderId":"1"}}`))
+		},
+		"/api/v1/public/quote/getTicker": test.JSONResponse(`{"code":"SUCCESS","data":[{"contractId":"10000001","oraclePrice":"30000","lastPrice":"30000"}]}`),
+	})
+	defer server.Close()
+	client := test.NewMockClient(t, &sdk.ClientConfig{BaseURL: server.URL})
+
+	bracket := func(orderType order.OrderType, tp, sl *order.TpSlParams) *order.BracketOrderParams {
+		// Market entries keep the price as their worst price
+		entry := limitOrder("30000")
+		entry.Type = orderType
+		return &order.BracketOrderParams{CreateOrderParams: *entry, TakeProfit: tp, StopLoss: sl}
+	}
+
+	tests := []struct {
+		name   string
+		params *order.BracketOrderParams
+		field  string
+	}{
+		{
+			name:   "stop loss above the last price of a market buy",
+			params: bracket(order.OrderTypeMarket, nil, &order.TpSlParams{TriggerPrice: "31000"}),
+			field:  "StopLoss.TriggerPrice",
+		},
+		{
+			name:   "take profit below the last price of a market buy",
+			params: bracket(order.OrderTypeMarket, &order.TpSlParams{TriggerPrice: "29000"}, nil),
+			field:  "TakeProfit.TriggerPrice",
+		},
+		{
+			name:   "trigger price off the tick size",
+			params: bracket(order.OrderTypeLimit, &order.TpSlParams{TriggerPrice: "31000.05"}, nil),
+			field:  "TakeProfit.TriggerPrice",
+		},
+		{
+			name:   "limit price off the tick size",
+			params: bracket(order.OrderTypeLimit, &order.TpSlParams{TriggerPrice: "31000", Price: "31000.05"}, nil),
+			field:  "TakeProfit.Price",
+		},
+		{
+			name:   "size off the step size",
+			params: bracket(order.OrderTypeLimit, nil, &order.TpSlParams{TriggerPrice: "29000", Size: "0.0505"}),
+			field:  "StopLoss.Size",
+		},
+		{
+			name:   "size above the entry size",
+			params: bracket(order.OrderTypeLimit, nil, &order.TpSlParams{TriggerPrice: "29000", Size: "0.2"}),
+			field:  "StopLoss.Size",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.CreateBracketOrder(test.GetTestContext(), tt.params)
+			var validationErr *order.ValidationError
+			if assert.True(t, errors.As(err, &validationErr), "unexpected error: %v", err) {
+				assert.Equal(t, tt.field, validationErr.Field)
+			}
+		})
+	}
+	assert.Equal(t, int32(0), atomic.LoadInt32(&created))
+
+	_, err := client.CreateBracketOrder(test.GetTestContext(), bracket(order.OrderTypeMarket,
+		&order.TpSlParams{TriggerPrice: "31000"}, &order.TpSlParams{TriggerPrice: "29000", Size: "0.05"}))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&created))
+}
+
+func TestTriggerValidation(t *testing.T) {
+	var tickers int32
+	client, closeServer := newTestClient(t, &tickers)
+	defer closeServer()
+
+	// The current last and oracle price is 30000
+	tests := []struct {
+		name         string
+		orderType    order.OrderType
+		side         string
+		triggerPrice string
+		priceType    order.TriggerPriceType
+		field        string // Empty if the order is valid
+	}{
+		{"buy stop above", order.OrderTypeStopLimit, order.OrderSideBuy, "30500", "", ""},
+		{"buy stop below", order.OrderTypeStopLimit, order.OrderSideBuy, "29500", "", "TriggerPrice"},
+		{"sell stop below", order.OrderTypeStopLimit, order.OrderSideSell, "29500", "", ""},
+		{"sell stop above", order.OrderTypeStopLimit, order.OrderSideSell, "30500", "", "TriggerPrice"},
+		{"buy take profit below", order.OrderTypeTakeProfitLimit, order.OrderSideBuy, "29500", "", ""},
+		{"buy take profit above", order.OrderTypeTakeProfitLimit, order.OrderSideBuy, "30500", "", "TriggerPrice"},
+		{"sell take profit above", order.OrderTypeTakeProfitLimit, order.OrderSideSell, "30500", "", ""},
+		{"sell take profit below", order.OrderTypeTakeProfitLimit, order.OrderSideSell, "29500", "", "TriggerPrice"},
+		{"trigger at the current price", order.OrderTypeStopLimit, order.OrderSideBuy, "30000", order.TriggerPriceTypeOracle, "TriggerPrice"},
+		{"trigger off the tick size", order.OrderTypeStopLimit, order.OrderSideBuy, "30500.05", "", "TriggerPrice"},
+		{"unsupported trigger price type", order.OrderTypeStopLimit, order.OrderSideBuy, "30500", "MARK_PRICE", "TriggerPriceType"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := limitOrder("30000")
+			params.Type = tt.orderType
+			params.Side = tt.side
+			params.TriggerPrice = tt.triggerPrice
+			params.TriggerPriceType = tt.priceType
+
+			_, err := client.CreateOrder(test.GetTestContext(), params)
+			if tt.field == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var validationErr *order.ValidationError
+			if assert.True(t, errors.As(err, &validationErr), "unexpected error: %v", err) {
+				assert.Equal(t, tt.field, validationErr.Field)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package withdraw
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk"
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/withdraw"
+	"github.com/edgex-Tech/edgex-golang-sdk/starkcurve"
+	"github.com/edgex-Tech/edgex-golang-sdk/test"
+	"github.com/stretchr/testify/assert"
+)
+
+const testMetaData = `{"code":"SUCCESS","data":{
+	"global":{"starkExCollateralCoin":{"coinId":"1000","starkExAssetId":"0x2"}},
+	"coinList":[{"coinId":"1000","starkExAssetId":"0x2"}],
+	"multiChain":{"coinId":"1000","chainList":[{"chainId":"1","tokenList":[
+		{"tokenAddress":"0xdAC17F958D2ee523a2206206994597C13D831ec7","decimals":"6","withdrawEnable":true}]}]}}}`
+
+const testEthAddress = "0x1fB51aa234287C3CA1F957eA9AD0E148Bb814b7A"
+
+func TestCreateWithdraw(t *testing.T) {
+	var param map[string]string
+	server := test.NewMockServer(t, testMetaData, map[string]http.HandlerFunc{
+		"/api/v1/private/withdraw/createWithdraw": func(w http.ResponseWriter, r *http.Request) {
+			json.NewDecoder(r.Body).Decode(&param)
+			w.Write([]byte(`{"code":"SUCCESS","data":{"withdrawId":"3"}}`))
+		},
+	})
+	defer server.Close()
+	client := test.NewMockClient(t, &sdk.ClientConfig{BaseURL: server.URL})
+
+	resp, err := client.CreateWithdraw(context.Background(), withdraw.CreateWithdrawParams{
+		CoinId:           "1000",
+		Amount:           "2.25",
+		EthAddress:       testEthAddress,
+		ClientWithdrawId: "withdraw-1",
+	})
+	assert.NoError(t, err)
+	data := resp.GetData()
+	assert.Equal(t, "3", data.GetWithdrawId())
+
+	assert.Equal(t, "withdraw-1", param["clientWithdrawId"])
+	nonce := test.L2Nonce("withdraw-1")
+	assert.Equal(t, nonce.String(), param["l2Nonce"])
+	expireTime, err := strconv.ParseInt(param["l2ExpireTime"], 10, 64)
+	assert.NoError(t, err)
+
+	// Rebuild the withdrawal message of the StarkEx perpetual spec
+	packed := test.PackFields(6, [2]int64{64, 12345}, [2]int64{32, nonce.Int64()}, [2]int64{64, 2250000}, [2]int64{32, expireTime / 3600000})
+	packed.Lsh(packed, 49)
+	msg := starkcurve.CalcHash([]*big.Int{big.NewInt(2), packed})
+	test.VerifyL2Signature(t, msg, param["l2Signature"])
+}
+
+func TestActiveWithdrawsPager(t *testing.T) {
+	server := test.NewMockServer(t, testMetaData, map[string]http.HandlerFunc{
+		"/api/v1/private/withdraw/getActiveWithdraw": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "1000", r.URL.Query().Get("filterCoinIdList"))
+			page := map[string]interface{}{"dataList": []map[string]string{{"id": "1"}, {"id": "2"}}, "nextPageOffsetData": "next"}
+			if r.URL.Query().Get("offsetData") == "next" {
+				page = map[string]interface{}{"dataList": []map[string]string{{"id": "3"}}}
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"code": "SUCCESS", "data": page})
+		},
+	})
+	defer server.Close()
+	client := test.NewMockClient(t, &sdk.ClientConfig{BaseURL: server.URL})
+
+	withdraws, err := client.ActiveWithdrawsPager(withdraw.GetActiveWithdrawParams{FilterCoinIdList: []string{"1000"}}, nil).All(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, withdraws, 3)
+}
+
+func TestGetWithdrawal(t *testing.T) {
+	server := test.NewMockServer(t, testMetaData, map[string]http.HandlerFunc{
+		"/api/v1/private/withdraw/getWithdrawById": func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"code":"SUCCESS","data":[{"id":"1","status":"FAILED_CENSOR_FAILURE","censorFailReason":"risk","createdTime":"1700000000000"}]}`))
+		},
+		"/api/v1/private/assets/getNormalWithdrawById": func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"code":"SUCCESS","data":[{"id":"2","status":"NORMAL_WITHDRAW_SUCCESS_L1_COMPLETED"}]}`))
+		},
+		"/api/v1/private/assets/getFastWithdrawById": func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"code":"SUCCESS","data":[{"id":"3","status":"FAST_WITHDRAW_PENDING_L1_TRY","fee":"0.5","chainId":"1"}]}`))
+		},
+		"/api/v1/private/assets/getCrossWithdrawById": func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"code":"SUCCESS","data":[{"id":"4","status":"CROSS_WITHDRAW_SUCCESS"}]}`))
+		},
+	})
+	defer server.Close()
+	client := test.NewMockClient(t, &sdk.ClientConfig{BaseURL: server.URL})
+
+	for _, tc := range []struct {
+		kind  withdraw.Kind
+		id    string
+		state withdraw.State
+	}{
+		{withdraw.KindWithdraw, "1", withdraw.StateFailed},
+		{withdraw.KindNormalWithdraw, "2", withdraw.StateSucceeded},
+		{withdraw.KindFastWithdraw, "3", withdraw.StatePending},
+		{withdraw.KindCrossWithdraw, "4", withdraw.StateSucceeded},
+	} {
+		w, err := client.GetWithdrawal(context.Background(), tc.kind, tc.id)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.kind, w.Kind)
+		assert.Equal(t, tc.id, w.Id)
+		assert.Equal(t, tc.state, w.State)
+	}
+
+	w, err := client.GetWithdrawal(context.Background(), withdraw.KindWithdraw, "1")
+	assert.NoError(t, err)
+	assert.Equal(t, "risk", w.FailureReason)
+	assert.Equal(t, int64(1700000000000), w.CreatedTime.UnixMilli())
+}
@@ -0,0 +1,132 @@
+package ws_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/ws"
+	"github.com/edgex-Tech/edgex-golang-sdk/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountStream(t *testing.T) {
+	server := newMockServer(t)
+	defer server.Close()
+
+	manager := ws.NewManager(server.wsURL(), test.TestAccountID, test.TestStarkPrivateKey)
+	stream, err := manager.AccountStream()
+	assert.NoError(t, err)
+	orders := make(chan ws.OrderUpdate, 4)
+	stream.OnOrders(func(update ws.OrderUpdate) {
+		orders <- update
+	})
+	positions := make(chan ws.PositionUpdate, 4)
+	stream.OnPositions(func(update ws.PositionUpdate) {
+		positions <- update
+	})
+	fills := make(chan ws.OrderFillUpdate, 4)
+	stream.OnOrderFills(func(update ws.OrderFillUpdate) {
+		fills <- update
+	})
+
+	assert.NoError(t, manager.ConnectPrivate(context.Background()))
+	defer manager.Close()
+	assert.Eventually(t, func() bool {
+		return atomic.LoadInt32(&server.connections) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// Updates before the snapshot are held back, and dropped if the snapshot contains them
+	server.send(t, `{"type":"trade-event","content":{"event":"ORDER_UPDATE","version":"5","data":{"order":[{"id":"1","status":"OPEN"}]}}}`)
+	server.send(t, `{"type":"trade-event","content":{"event":"ORDER_UPDATE","version":"11","data":{"order":[{"id":"1","status":"CANCELED"}]}}}`)
+	server.send(t, `{"type":"trade-event","content":{"event":"Snapshot","version":"10","data":{
+		"order":[{"id":"1","status":"OPEN","price":"100"}],"position":[{"contractId":"10000001","openSize":"1"}]}}}`)
+	server.send(t, `{"type":"trade-event","content":{"event":"ORDER_FILL_UPDATE","version":"12","data":{
+		"orderFillTransaction":[{"id":"7","orderId":"2","fillSize":"0.5"}]}}}`)
+
+	snapshot := receive(t, orders)
+	assert.True(t, snapshot.Snapshot)
+	assert.Equal(t, "10", snapshot.Version)
+	assert.Equal(t, "100", snapshot.Data[0].GetPrice())
+	positionSnapshot := receive(t, positions)
+	assert.True(t, positionSnapshot.Snapshot)
+	assert.Equal(t, "1", positionSnapshot.Data[0].GetOpenSize())
+	fillSnapshot := receive(t, fills)
+	assert.True(t, fillSnapshot.Snapshot)
+	assert.Empty(t, fillSnapshot.Data)
+
+	canceled := receive(t, orders)
+	assert.False(t, canceled.Snapshot)
+	assert.Equal(t, "ORDER_UPDATE", canceled.Event)
+	assert.Equal(t, "CANCELED", canceled.Data[0].GetStatus())
+
+	fill := receive(t, fills)
+	assert.Equal(t, "0.5", fill.Data[0].GetFillSize())
+	select {
+	case update := <-orders:
+		t.Fatalf("unexpected order update %+v", update)
+	default:
+	}
+
+	// The cancel was already reported
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	final, err := stream.WaitForOrderFinal(ctx, "1")
+	assert.NoError(t, err)
+	assert.Equal(t, "CANCELED", final.GetStatus())
+}
+
+func TestAccountStreamWaitForOrderFinal(t *testing.T) {
+	server := newMockServer(t)
+	defer server.Close()
+
+	manager := ws.NewManager(server.wsURL(), test.TestAccountID, test.TestStarkPrivateKey)
+	stream, err := manager.AccountStream()
+	assert.NoError(t, err)
+	assert.NoError(t, manager.ConnectPrivate(context.Background()))
+	defer manager.Close()
+	assert.Eventually(t, func() bool {
+		return atomic.LoadInt32(&server.connections) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	server.send(t, `{"type":"trade-event","content":{"event":"Snapshot","version":"1","data":{}}}`)
+
+	result := make(chan string, 1)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		final, err := stream.WaitForOrderFinal(ctx, "2")
+		if err != nil {
+			result <- err.Error()
+			return
+		}
+		result <- final.GetStatus()
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	server.send(t, `{"type":"trade-event","content":{"event":"ORDER_UPDATE","version":"2","data":{"order":[{"id":"2","status":"OPEN"}]}}}`)
+	server.send(t, `{"type":"trade-event","content":{"event":"ORDER_UPDATE","version":"3","data":{"order":[{"id":"2","status":"FILLED"}]}}}`)
+	assert.Equal(t, "FILLED", receive(t, result))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = stream.WaitForOrderFinal(ctx, "3")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestAccountStreamAfterConnect(t *testing.T) {
+	server := newMockServer(t)
+	defer server.Close()
+
+	// The snapshot of the connection was already sent, so a late stream is refused
+	manager := ws.NewManager(server.wsURL(), test.TestAccountID, test.TestStarkPrivateKey)
+	assert.NoError(t, manager.ConnectPrivate(context.Background()))
+	defer manager.Close()
+	_, err := manager.AccountStream()
+	assert.Error(t, err)
+
+	client := ws.NewClient(server.wsURL(), true, test.TestAccountID, test.TestStarkPrivateKey)
+	assert.NoError(t, client.Connect(context.Background()))
+	defer client.Close()
+	assert.Error(t, ws.NewAccountStream().Attach(client))
+}
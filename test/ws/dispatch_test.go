@@ -0,0 +1,133 @@
+package ws_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/ws"
+	"github.com/stretchr/testify/assert"
+)
+
+func tickerMessage(contractID string) string {
+	return fmt.Sprintf(`{"type":"quote-event","channel":"ticker.%[1]s","content":{"channel":"ticker.%[1]s","dataType":"Snapshot","data":[{"contractId":"%[1]s"}]}}`, contractID)
+}
+
+// noFrame fails if the server receives a frame on ch within a short time
+func noFrame(t *testing.T, ch <-chan string) {
+	t.Helper()
+	select {
+	case channel := <-ch:
+		t.Fatalf("unexpected frame for %s", channel)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestHandlersPerChannel(t *testing.T) {
+	server := newMockServer(t)
+	defer server.Close()
+
+	manager := ws.NewManager(server.wsURL(), 0, "")
+	assert.NoError(t, manager.ConnectPublic(context.Background()))
+	defer manager.Close()
+
+	first := make(chan string, 4)
+	second := make(chan string, 4)
+	firstSub, err := manager.SubscribeMarketTicker("10000001", func(message []byte) {
+		first <- "10000001"
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "ticker.10000001", firstSub.Channel())
+	_, err = manager.SubscribeMarketTicker("10000002", func(message []byte) {
+		second <- "10000002"
+	})
+	assert.NoError(t, err)
+	receive(t, server.subscribes)
+	receive(t, server.subscribes)
+
+	// A second handler of the same channel receives the events as well, without
+	// subscribing the channel again
+	typed, events := ws.ChanHandler[ws.TickerEvent](4)
+	typedSub, err := manager.SubscribeTickerEvents("10000001", typed)
+	assert.NoError(t, err)
+	noFrame(t, server.subscribes)
+
+	server.send(t, tickerMessage("10000001"))
+	server.send(t, tickerMessage("10000002"))
+	assert.Equal(t, "10000001", receive(t, first))
+	assert.Equal(t, "10000002", receive(t, second))
+	assert.Equal(t, "ticker.10000001", receive(t, events).Channel)
+
+	// Removing one handler keeps the channel subscribed for the others
+	assert.NoError(t, typedSub.Unsubscribe())
+	noFrame(t, server.unsubscribes)
+	server.send(t, tickerMessage("10000001"))
+	assert.Equal(t, "10000001", receive(t, first))
+	select {
+	case <-events:
+		t.Fatal("typed handler called after unsubscribe")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// Removing the last handler unsubscribes the channel
+	assert.NoError(t, firstSub.Unsubscribe())
+	assert.Equal(t, "ticker.10000001", receive(t, server.unsubscribes))
+	assert.NoError(t, firstSub.Unsubscribe())
+	noFrame(t, server.unsubscribes)
+
+	// Unsubscribing a channel removes every handler of the channel only
+	_, err = manager.SubscribeMarketTicker("10000001", func(message []byte) {
+		first <- "10000001"
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "ticker.10000001", receive(t, server.subscribes))
+	assert.NoError(t, manager.Unsubscribe("ticker.10000001"))
+	assert.Equal(t, "ticker.10000001", receive(t, server.unsubscribes))
+	server.send(t, tickerMessage("10000001"))
+	server.send(t, tickerMessage("10000002"))
+	assert.Equal(t, "10000002", receive(t, second))
+	select {
+	case <-first:
+		t.Fatal("handler called after unsubscribe")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestHandlersConcurrentAccess(t *testing.T) {
+	server := newMockServer(t)
+	defer server.Close()
+
+	client := ws.NewClient(server.wsURL(), false, 0, "")
+	assert.NoError(t, client.Connect(context.Background()))
+	defer client.Close()
+
+	go func() {
+		for range server.subscribes {
+		}
+	}()
+	go func() {
+		for range server.unsubscribes {
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			channel := fmt.Sprintf("ticker.1000000%d", i)
+			for j := 0; j < 20; j++ {
+				client.OnMessage(channel, func([]byte) {})
+				client.OnMessageHook(func([]byte) {})
+				client.OnConnect(func() {})
+				client.OnDisconnect(func(error) {})
+				assert.NoError(t, client.Subscribe(channel, nil))
+				server.send(t, tickerMessage(fmt.Sprintf("1000000%d", i)))
+				assert.NoError(t, client.Unsubscribe(channel))
+			}
+		}(i)
+	}
+	wg.Wait()
+}
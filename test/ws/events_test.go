@@ -0,0 +1,84 @@
+package ws_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/ws"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+// send writes a message to every open connection
+func (s *mockServer) send(t *testing.T, message string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, conn := range s.conns {
+		assert.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(message)))
+	}
+}
+
+func TestSubscribeDepthEvents(t *testing.T) {
+	server := newMockServer(t)
+	defer server.Close()
+
+	manager := ws.NewManager(server.wsURL(), 0, "")
+	assert.NoError(t, manager.ConnectPublic(context.Background()))
+	defer manager.Close()
+
+	handler, events := ws.ChanHandler[ws.DepthEvent](4)
+	_, err := manager.SubscribeDepthEvents("10000001", handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "depth.10000001.15", receive(t, server.subscribes))
+
+	server.send(t, `{"type":"quote-event","channel":"depth.10000001.15","content":{"channel":"depth.10000001.15","dataType":"Snapshot",
+		"data":[{"startVersion":"1","endVersion":"10","contractId":"10000001","asks":[{"price":"101","size":"2"}],"bids":[{"price":"99","size":"1"}]}]}}`)
+	server.send(t, `{"type":"quote-event","channel":"depth.10000001.15","content":{"channel":"depth.10000001.15","dataType":"Changed",
+		"data":[{"startVersion":"11","endVersion":"11","contractId":"10000001","bids":[{"price":"99","size":"0"}]}]}}`)
+
+	snapshot := receive(t, events)
+	assert.Equal(t, ws.DataTypeSnapshot, snapshot.DataType)
+	assert.Equal(t, "depth.10000001.15", snapshot.Channel)
+	assert.Len(t, snapshot.Data, 1)
+	asks := snapshot.Data[0].GetAsks()
+	assert.Equal(t, "101", asks[0].GetPrice())
+	assert.Equal(t, "10", snapshot.Data[0].GetEndVersion())
+
+	changed := receive(t, events)
+	assert.Equal(t, ws.DataTypeChanged, changed.DataType)
+	bids := changed.Data[0].GetBids()
+	assert.Equal(t, "0", bids[0].GetSize())
+}
+
+func TestSubscribeTickerAndTradeEvents(t *testing.T) {
+	server := newMockServer(t)
+	defer server.Close()
+
+	manager := ws.NewManager(server.wsURL(), 0, "")
+	assert.NoError(t, manager.ConnectPublic(context.Background()))
+	defer manager.Close()
+
+	tickers := make(chan ws.TickerEvent, 1)
+	_, err := manager.SubscribeTickerEvents("10000001", func(ev ws.TickerEvent) {
+		tickers <- ev
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "ticker.10000001", receive(t, server.subscribes))
+	tradeHandler, trades := ws.ChanHandler[ws.TradeEvent](1)
+	_, err = manager.SubscribeTradeEvents("10000001", tradeHandler)
+	assert.NoError(t, err)
+	assert.Equal(t, "trades.10000001", receive(t, server.subscribes))
+
+	server.send(t, `{"type":"quote-event","channel":"ticker.10000001","content":{"channel":"ticker.10000001","dataType":"Snapshot",
+		"data":[{"contractId":"10000001","lastPrice":"65000.5","fundingRate":"0.0001"}]}}`)
+	server.send(t, `{"type":"quote-event","channel":"trades.10000001","content":{"channel":"trades.10000001","dataType":"Changed",
+		"data":[{"ticketId":"7","price":"65000.5","size":"0.01","isBuyerMaker":true}]}}`)
+
+	ticker := receive(t, tickers)
+	assert.Equal(t, ws.DataTypeSnapshot, ticker.DataType)
+	assert.Equal(t, "65000.5", ticker.Data[0].GetLastPrice())
+
+	trade := receive(t, trades)
+	assert.Equal(t, ws.DataTypeChanged, trade.DataType)
+	assert.Equal(t, ws.Trade{TicketId: "7", Price: "65000.5", Size: "0.01", IsBuyerMaker: true}, trade.Data[0])
+}
@@ -0,0 +1,229 @@
+package ws_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/ws"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockServer is a WebSocket server that records subscriptions and can drop connections
+type mockServer struct {
+	*httptest.Server
+	mu           sync.Mutex
+	conns        []*websocket.Conn
+	subscribes   chan string
+	unsubscribes chan string
+	connections  int32
+	reject       int32 // Reject upgrades while set
+}
+
+func newMockServer(t *testing.T) *mockServer {
+	s := &mockServer{subscribes: make(chan string, 16), unsubscribes: make(chan string, 16)}
+	upgrader := websocket.Upgrader{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(&s.reject) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Errorf("upgrade: %v", err)
+			return
+		}
+		atomic.AddInt32(&s.connections, 1)
+		s.mu.Lock()
+		s.conns = append(s.conns, conn)
+		s.mu.Unlock()
+		for {
+			_, message, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			var msg map[string]string
+			json.Unmarshal(message, &msg)
+			switch msg["type"] {
+			case "subscribe":
+				s.subscribes <- msg["channel"]
+			case "unsubscribe":
+				s.unsubscribes <- msg["channel"]
+			}
+		}
+	}))
+	return s
+}
+
+// dropAll closes every open connection on the server side
+func (s *mockServer) dropAll() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, conn := range s.conns {
+		conn.Close()
+	}
+	s.conns = nil
+}
+
+func (s *mockServer) wsURL() string {
+	return "ws" + strings.TrimPrefix(s.URL, "http")
+}
+
+func receive[T any](t *testing.T, ch <-chan T) T {
+	t.Helper()
+	select {
+	case v := <-ch:
+		return v
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for event")
+		var zero T
+		return zero
+	}
+}
+
+func fastReconnect(maxAttempts int) *ws.ReconnectConfig {
+	return &ws.ReconnectConfig{
+		InitialBackoff: 10 * time.Millisecond,
+		MaxBackoff:     50 * time.Millisecond,
+		Multiplier:     2,
+		MaxAttempts:    maxAttempts,
+		DialTimeout:    time.Second,
+	}
+}
+
+func TestReconnectReplaysSubscriptions(t *testing.T) {
+	server := newMockServer(t)
+	defer server.Close()
+
+	client := ws.NewClient(server.wsURL(), false, 0, "")
+	client.SetReconnectConfig(fastReconnect(0))
+	events := make(chan ws.LifecycleEvent, 16)
+	client.OnLifecycle(func(e ws.LifecycleEvent) {
+		events <- e
+	})
+	assert.NoError(t, client.Connect(context.Background()))
+	defer client.Close()
+
+	assert.NoError(t, client.Subscribe("ticker.10000001", nil))
+	assert.Equal(t, "ticker.10000001", receive(t, server.subscribes))
+
+	server.dropAll()
+	assert.Equal(t, ws.EventReconnecting, receive(t, events).Type)
+	assert.Equal(t, "ticker.10000001", receive(t, server.subscribes))
+	reconnected := receive(t, events)
+	assert.Equal(t, ws.EventReconnected, reconnected.Type)
+	assert.Equal(t, 1, reconnected.Attempt)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&server.connections))
+}
+
+func TestReconnectGivesUp(t *testing.T) {
+	server := newMockServer(t)
+	defer server.Close()
+
+	client := ws.NewClient(server.wsURL(), false, 0, "")
+	client.SetReconnectConfig(fastReconnect(2))
+	events := make(chan ws.LifecycleEvent, 16)
+	client.OnLifecycle(func(e ws.LifecycleEvent) {
+		events <- e
+	})
+	assert.NoError(t, client.Connect(context.Background()))
+	defer client.Close()
+
+	atomic.StoreInt32(&server.reject, 1)
+	server.dropAll()
+	assert.Equal(t, ws.EventReconnecting, receive(t, events).Type)
+	assert.Equal(t, ws.EventReconnecting, receive(t, events).Type)
+	gaveUp := receive(t, events)
+	assert.Equal(t, ws.EventGaveUp, gaveUp.Type)
+	assert.Error(t, gaveUp.Err)
+
+	// A client that gave up can be connected again
+	atomic.StoreInt32(&server.reject, 0)
+	assert.NoError(t, client.Connect(context.Background()))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&server.connections))
+}
+
+func TestManagerDropsClientThatGaveUp(t *testing.T) {
+	server := newMockServer(t)
+	defer server.Close()
+
+	manager := ws.NewManager(server.wsURL(), 0, "")
+	manager.SetReconnectConfig(fastReconnect(1))
+	events := make(chan ws.LifecycleEvent, 16)
+	manager.OnLifecycle(func(e ws.LifecycleEvent) {
+		events <- e
+	})
+	assert.NoError(t, manager.ConnectPublic(context.Background()))
+	defer manager.Close()
+
+	atomic.StoreInt32(&server.reject, 1)
+	server.dropAll()
+	assert.Equal(t, ws.EventReconnecting, receive(t, events).Type)
+	assert.Equal(t, ws.EventGaveUp, receive(t, events).Type)
+	_, err := manager.SubscribeMarketTicker("10000001", func([]byte) {})
+	assert.Error(t, err)
+
+	// Connecting again replaces the client
+	atomic.StoreInt32(&server.reject, 0)
+	assert.NoError(t, manager.ConnectPublic(context.Background()))
+	_, err = manager.SubscribeMarketTicker("10000001", func([]byte) {})
+	assert.NoError(t, err)
+	assert.Equal(t, "ticker.10000001", receive(t, server.subscribes))
+}
+
+func TestSubscribeDuringOutage(t *testing.T) {
+	server := newMockServer(t)
+	defer server.Close()
+
+	client := ws.NewClient(server.wsURL(), false, 0, "")
+	client.SetReconnectConfig(fastReconnect(0))
+	events := make(chan ws.LifecycleEvent, 64)
+	client.OnLifecycle(func(e ws.LifecycleEvent) {
+		events <- e
+	})
+	assert.NoError(t, client.Connect(context.Background()))
+	defer client.Close()
+
+	atomic.StoreInt32(&server.reject, 1)
+	server.dropAll()
+	assert.Equal(t, ws.EventReconnecting, receive(t, events).Type)
+
+	// The topic is recorded and subscribed once the connection is restored
+	assert.NoError(t, client.Subscribe("ticker.10000001", nil))
+	atomic.StoreInt32(&server.reject, 0)
+	assert.Equal(t, "ticker.10000001", receive(t, server.subscribes))
+}
+
+func TestReconnectAfterClose(t *testing.T) {
+	server := newMockServer(t)
+	defer server.Close()
+
+	client := ws.NewClient(server.wsURL(), false, 0, "")
+	client.SetReconnectConfig(fastReconnect(0))
+	events := make(chan ws.LifecycleEvent, 16)
+	client.OnLifecycle(func(e ws.LifecycleEvent) {
+		events <- e
+	})
+
+	assert.NoError(t, client.Connect(context.Background()))
+	assert.NoError(t, client.Close())
+	assert.NoError(t, client.Connect(context.Background()))
+	defer client.Close()
+	assert.Eventually(t, func() bool {
+		return atomic.LoadInt32(&server.connections) == 2
+	}, time.Second, 10*time.Millisecond)
+
+	// Closing the client must not trigger a reconnect
+	select {
+	case e := <-events:
+		t.Fatalf("unexpected lifecycle event: %s", e.Type)
+	case <-time.After(100 * time.Millisecond):
+	}
+}